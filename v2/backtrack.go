@@ -0,0 +1,110 @@
+package wordsearch
+
+import (
+	"context"
+	"errors"
+	"time"
+)
+
+// ErrBudgetExceeded means the backtracking search ran out of steps or time before it could fit every word
+var ErrBudgetExceeded = errors.New("the backtracking search ran out of steps or time")
+
+// backtracking holds the limits on how long CreatePuzzle can spend searching for an arrangement of words
+type backtracking struct {
+	maxSteps int
+	timeout  time.Duration
+	steps    int
+	deadline time.Time
+}
+
+// The WithBacktracking option makes CreatePuzzle search for an arrangement that fits every word,
+// instead of placing words one at a time and never revisiting a choice. When a word can't fit,
+// the words placed before it are moved until it does. Every attempt to place a word is a step,
+// and the search stops after maxSteps steps or once timeout has passed, whichever comes first.
+// Zero means there's no limit, which could take a very long time if the words can't all fit.
+// If the search doesn't succeed, the deepest partial arrangement it found is kept, any remaining
+// words are placed the usual way, and if any of them still don't fit then Err reports ErrBudgetExceeded.
+func WithBacktracking(maxSteps int, timeout time.Duration) Option {
+	return func(ws *WordSearch) {
+		ws.backtracking = &backtracking{maxSteps: maxSteps, timeout: timeout}
+	}
+}
+
+// spent returns true if the search has used up its budget or the context is done
+func (b *backtracking) spent(ctx context.Context) bool {
+	if ctx.Err() != nil {
+		return true
+	}
+	if b.maxSteps > 0 && b.steps >= b.maxSteps {
+		return true
+	}
+	return b.timeout > 0 && time.Now().After(b.deadline)
+}
+
+// placeWithBacktracking places every entry using a depth-first search over all of their possible positions.
+// It returns the entries that couldn't be placed.
+func (ws *WordSearch) placeWithBacktracking(ctx context.Context, entries []entry) (unplaced []Unplaced) {
+	b := ws.backtracking
+	b.steps = 0
+	b.deadline = time.Now().Add(b.timeout)
+
+	start := len(ws.placements)
+	var best []Placement
+	var search func(i int) bool
+	search = func(i int) bool {
+		if len(ws.placements)-start > len(best) {
+			best = append(best[:0], ws.placements[start:]...)
+		}
+		if i == len(entries) {
+			return true
+		}
+		for _, cand := range ws.shuffledCandidates(entries[i].word, ws.Directions) {
+			if b.spent(ctx) {
+				return false
+			}
+			b.steps++
+			previous := ws.lettersAt(len(entries[i].word), cand)
+			if ws.place(entries[i], cand) != nil {
+				continue
+			}
+			if search(i + 1) {
+				return true
+			}
+			ws.unplaceLast(previous)
+		}
+		return false
+	}
+	if search(0) {
+		return nil
+	}
+
+	// the search has undone all of its placements, so put back the best partial arrangement it found
+	// and then place the rest of the words the usual way
+	for _, p := range best {
+		ws.place(entry{word: []rune(p.Word), display: p.Display}, candidate{row: p.Row, col: p.Col, cardinal: p.Cardinal})
+	}
+	unplaced = ws.placeEach(ctx, entries[len(best):])
+	if unplaced != nil && b.spent(ctx) {
+		ws.err = ErrBudgetExceeded
+	}
+	return unplaced
+}
+
+// lettersAt returns the letters currently in the grid where a word of the given length would be placed
+func (ws *WordSearch) lettersAt(length int, cand candidate) []rune {
+	previous := make([]rune, length)
+	for i, cell := range cand.cells(length) {
+		previous[i] = ws.Grid[cell[0]][cell[1]]
+	}
+	return previous
+}
+
+// unplaceLast removes the most recently placed word, putting back the letters that were there before it
+func (ws *WordSearch) unplaceLast(previous []rune) {
+	id := len(ws.placements) - 1
+	for i, cell := range ws.placements[id].cells() {
+		ws.Grid[cell[0]][cell[1]] = previous[i]
+		ws.uncover(cell[0], cell[1], id)
+	}
+	ws.placements = ws.placements[:len(ws.placements)-1]
+}
@@ -0,0 +1,39 @@
+package wordsearch
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestWithBacktracking fills a grid that greedy placement often can't finish
+func TestWithBacktracking(t *testing.T) {
+	// CAT has to go along an edge, or else the three short words won't fit around it
+	words := []string{"CAT", "DO", "GO", "UP"}
+	for seed := range int64(20) {
+		ws := newWordSearch(t, 3, WithSeed(seed), WithDirections([]string{"E", "S"}), WithoutOverlaps(), WithBacktracking(0, 0))
+		unplaced := ws.CreatePuzzle(words)
+		if len(unplaced) > 0 {
+			t.Errorf("seed %d: expected every word to be placed, got unplaced %v", seed, unplaced)
+			printGrid(t, ws.ReturnGrid(GridWithDots))
+		}
+		if len(ws.Placements()) != len(words) {
+			t.Errorf("seed %d: expected %d placements, got %d", seed, len(words), len(ws.Placements()))
+		}
+	}
+
+	t.Run("an impossible list runs out of budget", func(t *testing.T) {
+		ws := newWordSearch(t, 3, WithDirections([]string{"E", "S"}), WithoutOverlaps(), WithBacktracking(50, time.Second))
+		unplaced := ws.CreatePuzzle([]string{"CAT", "DO", "GO", "UP", "IN"})
+		if len(unplaced) == 0 {
+			t.Errorf("expected unplaced words")
+		}
+		if !errors.Is(ws.Err(), ErrBudgetExceeded) {
+			t.Errorf("expected ErrBudgetExceeded, got %v", ws.Err())
+		}
+		if len(ws.Placements())+len(unplaced) != 5 {
+			t.Errorf("expected every word to be placed or unplaced, got %d placed and %v", len(ws.Placements()), unplaced)
+		}
+		printGrid(t, ws.ReturnGrid(GridWithDots))
+	})
+}
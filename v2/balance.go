@@ -0,0 +1,98 @@
+package wordsearch
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// The WithBalancedDirections option spreads the placed words evenly across the allowed directions.
+// Words that fit most easily (usually E and S) would otherwise end up being most of the puzzle.
+// See WithDirectionWeights for how the words are steered.
+func WithBalancedDirections() Option {
+	return func(ws *WordSearch) {
+		ws.directionWeights = map[string]float64{}
+	}
+}
+
+// The WithDirectionWeights option sets the share of placed words that should run in each direction.
+// The keys are directions, in any of the forms WithDirections accepts, and the weights are relative to each other,
+// so {"E": 3, "S": 3, "W": 2, "N": 2} asks for 30% of the words to run E, 30% S, 20% W and 20% N.
+// Allowed directions that aren't in the map (or have a weight of zero) are only used when a word won't fit any other way.
+// Each word tries the direction that is furthest behind its target first, then the next furthest, and so on.
+// This takes the place of WithBackwardRatio, and like it, has no effect when WithBacktracking is used.
+// NewWordSearch returns ErrUnknownDirection if one of the keys isn't recognized.
+func WithDirectionWeights(weights map[string]float64) Option {
+	return func(ws *WordSearch) {
+		ws.directionWeights = make(map[string]float64, len(weights))
+		for d, w := range weights {
+			ws.directionWeights[d] = max(w, 0)
+		}
+	}
+}
+
+// parseDirectionWeights turns the keys of the direction weights into cardinal directions
+func (ws *WordSearch) parseDirectionWeights() error {
+	parsed := make(map[string]float64, len(ws.directionWeights))
+	for d, w := range ws.directionWeights {
+		cardinal, ok := vector.ParseCardinal(d)
+		if !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownDirection, d)
+		}
+		parsed[cardinal] += w
+	}
+	ws.directionWeights = parsed
+	return nil
+}
+
+// neediestDirections returns each allowed direction with a weight on its own, starting with the one that
+// is furthest behind its share of the placed words and breaking ties at random.
+// It returns nil if there is no direction target.
+func (ws *WordSearch) neediestDirections() [][]string {
+	if ws.directionWeights == nil {
+		return nil
+	}
+	weights := make(map[string]float64)
+	total := 0.0
+	for _, cardinal := range ws.Directions {
+		w := 1.0
+		if len(ws.directionWeights) > 0 {
+			w = ws.directionWeights[cardinal]
+		}
+		if w > 0 && weights[cardinal] == 0 {
+			weights[cardinal] = w
+			total += w
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+
+	counts := make(map[string]int)
+	for _, p := range ws.placements {
+		counts[p.Cardinal]++
+	}
+	next := float64(len(ws.placements) + 1)
+	deficit := func(cardinal string) float64 {
+		return weights[cardinal]/total*next - float64(counts[cardinal])
+	}
+
+	var directions []string
+	for _, cardinal := range vector.Cardinals {
+		if weights[cardinal] > 0 {
+			directions = append(directions, cardinal)
+		}
+	}
+	ws.rng.Shuffle(len(directions), func(i, j int) {
+		directions[i], directions[j] = directions[j], directions[i]
+	})
+	sort.SliceStable(directions, func(i, j int) bool {
+		return deficit(directions[i]) > deficit(directions[j])
+	})
+	groups := make([][]string, len(directions))
+	for i := range directions {
+		groups[i] = directions[i : i+1]
+	}
+	return groups
+}
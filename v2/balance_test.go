@@ -0,0 +1,59 @@
+package wordsearch
+
+import (
+	"errors"
+	"testing"
+)
+
+// balanceWords are two words for each of the eight directions
+var balanceWords = []string{
+	"GOPHER", "CHANNEL", "SLICE", "STRUCT", "INTERFACE", "POINTER", "MAP", "GOROUTINE",
+	"PACKAGE", "MODULE", "DEFER", "SELECT", "RANGE", "BUFFER", "CLOSURE", "METHOD",
+}
+
+// directionCounts places the words and counts how many run in each direction
+func directionCounts(t *testing.T, opts ...Option) map[string]int {
+	t.Helper()
+	ws := newWordSearch(t, 12, opts...)
+	if unplaced := ws.CreatePuzzle(balanceWords); len(unplaced) > 0 {
+		t.Fatalf("expected no unplaced, got %v", unplaced)
+	}
+	counts := make(map[string]int)
+	for _, p := range ws.Placements() {
+		counts[p.Cardinal]++
+	}
+	return counts
+}
+
+// TestWithBalancedDirections checks that the words are spread evenly across the allowed directions
+func TestWithBalancedDirections(t *testing.T) {
+	for seed := range int64(10) {
+		counts := directionCounts(t, WithBalancedDirections(), WithSeed(seed))
+		for _, cardinal := range []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"} {
+			// 16 words over 8 directions is 2 each, give or take one that didn't fit its first choice
+			if counts[cardinal] < 1 || counts[cardinal] > 3 {
+				t.Errorf("seed %d: expected about 2 words running %s, got %v", seed, cardinal, counts)
+			}
+		}
+	}
+}
+
+// TestWithDirectionWeights checks that the words follow the weights, and never go in a direction without one
+// unless they have to
+func TestWithDirectionWeights(t *testing.T) {
+	weights := map[string]float64{"east": 3, "S": 1}
+	for seed := range int64(10) {
+		counts := directionCounts(t, WithDirectionWeights(weights), WithDirections([]string{"E", "S", "W"}), WithSeed(seed))
+		if counts["E"] < 11 || counts["E"] > 13 {
+			t.Errorf("seed %d: expected about 12 words running E, got %v", seed, counts)
+		}
+		if counts["S"] < 3 || counts["S"] > 5 || counts["W"] > 0 {
+			t.Errorf("seed %d: expected about 4 words running S and none running W, got %v", seed, counts)
+		}
+	}
+
+	_, err := NewWordSearch(10, WithDirectionWeights(map[string]float64{"up": 1}))
+	if !errors.Is(err, ErrUnknownDirection) {
+		t.Errorf("expected ErrUnknownDirection, got %v", err)
+	}
+}
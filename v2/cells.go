@@ -0,0 +1,59 @@
+package wordsearch
+
+// CellKind says what kind of letter is in a cell of the grid
+type CellKind int
+
+const (
+	CellFiller CellKind = iota // a random letter that isn't part of any placed word
+	CellPlaced                 // a letter of at least one placed word
+	CellBlank                  // a cell that a mask has left out of the puzzle
+)
+
+// Cell describes one cell of the grid. Words holds the index (into Placements) of every word that covers the cell,
+// so a cell where two words cross has two indexes. This doesn't depend on the case of the letter in the grid,
+// so it works for alphabets that don't have uppercase and lowercase letters.
+type Cell struct {
+	Kind  CellKind
+	Words []int
+}
+
+// createCells creates the metadata for a grid full of filler letters
+func createCells(width int, height int) [][]Cell {
+	cells := make([][]Cell, height)
+	for i := range cells {
+		cells[i] = make([]Cell, width)
+	}
+	return cells
+}
+
+// Cell returns the metadata for the cell at a specific row and column.
+// It returns a CellBlank cell if the row or column is outside the grid.
+func (ws *WordSearch) Cell(row int, col int) Cell {
+	if row < 0 || row >= ws.Height || col < 0 || col >= ws.Width {
+		return Cell{Kind: CellBlank}
+	}
+	cell := ws.cells[row][col]
+	cell.Words = append([]int(nil), cell.Words...)
+	return cell
+}
+
+// cover marks a cell as part of a placed word
+func (ws *WordSearch) cover(row int, col int, id int) {
+	ws.cells[row][col].Kind = CellPlaced
+	ws.cells[row][col].Words = append(ws.cells[row][col].Words, id)
+}
+
+// uncover removes a placed word from a cell, which turns back into filler if no other word covers it
+func (ws *WordSearch) uncover(row int, col int, id int) {
+	cell := &ws.cells[row][col]
+	for i, w := range cell.Words {
+		if w == id {
+			cell.Words = append(cell.Words[:i], cell.Words[i+1:]...)
+			break
+		}
+	}
+	if len(cell.Words) == 0 {
+		cell.Kind = CellFiller
+		cell.Words = nil
+	}
+}
@@ -0,0 +1,80 @@
+package wordsearch
+
+import (
+	"testing"
+)
+
+// TestCell checks the metadata for filler, placed, crossing and blank cells
+func TestCell(t *testing.T) {
+	mask := [][]bool{
+		{true, true, true, true},
+		{true, true, true, true},
+		{true, true, true, true},
+		{true, true, true, false},
+	}
+	ws := newWordSearch(t, 4, WithMask(mask))
+	if err := ws.PlaceWord("CAT", 0, 0, "E"); err != nil {
+		t.Fatalf("PlaceWord() error = %v", err)
+	}
+	if err := ws.PlaceWord("TOP", 0, 2, "S"); err != nil {
+		t.Fatalf("PlaceWord() error = %v", err)
+	}
+
+	tests := []struct {
+		name     string
+		row, col int
+		kind     CellKind
+		words    []int
+	}{
+		{"first letter of CAT", 0, 0, CellPlaced, []int{0}},
+		{"T where CAT and TOP cross", 0, 2, CellPlaced, []int{0, 1}},
+		{"last letter of TOP", 2, 2, CellPlaced, []int{1}},
+		{"filler", 1, 0, CellFiller, nil},
+		{"masked", 3, 3, CellBlank, nil},
+		{"outside the grid", 4, 0, CellBlank, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cell := ws.Cell(tt.row, tt.col)
+			if cell.Kind != tt.kind {
+				t.Errorf("expected kind %d, got %d", tt.kind, cell.Kind)
+			}
+			if len(cell.Words) != len(tt.words) {
+				t.Fatalf("expected words %v, got %v", tt.words, cell.Words)
+			}
+			for i := range tt.words {
+				if cell.Words[i] != tt.words[i] {
+					t.Errorf("expected words %v, got %v", tt.words, cell.Words)
+				}
+			}
+		})
+	}
+}
+
+// TestUncasedAlphabet places words from an alphabet without uppercase and lowercase letters
+func TestUncasedAlphabet(t *testing.T) {
+	ws := newWordSearch(t, 8, WithAlphabet("אבגדהוזחטיכלמנסעפצקרשת"), WithoutOverlaps(), WithSeed(6))
+	words := []string{"שלום", "תודה", "בית", "ספר"}
+	if unplaced := ws.CreatePuzzle(words); len(unplaced) > 0 {
+		t.Fatalf("expected no unplaced, got %v", unplaced)
+	}
+
+	placed := make(map[[2]int]bool)
+	for _, p := range ws.Placements() {
+		for _, cell := range p.cells() {
+			if placed[cell] {
+				t.Errorf("expected no overlaps, but %v is covered twice", cell)
+			}
+			placed[cell] = true
+		}
+	}
+	// the raw grid can't tell filler from placed letters, but the styled grid can
+	for r, row := range ws.ReturnGrid(GridWithDots) {
+		for c, letter := range row {
+			if placed[[2]int{r, c}] == (letter == '.') {
+				t.Errorf("[%d][%d]: placed is %v, but got %c", r, c, placed[[2]int{r, c}], letter)
+			}
+		}
+	}
+	printGrid(t, ws.ReturnGrid(GridWithDots))
+}
@@ -0,0 +1,105 @@
+package wordsearch
+
+import (
+	"context"
+
+	"github.com/rahji/wordsearch/v2/internal/letters"
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// The WithDecoys option makes puzzles harder by hiding false leads in the filler. For each placed word,
+// CreatePuzzle tries to add perWord decoys: either the start of the word (like GOPH for GOPHER) or the
+// whole word with one letter changed (like GOPHIR). Decoys only replace filler letters, and a decoy is
+// thrown away if it would make any hidden word appear more often than it did before.
+func WithDecoys(perWord int) Option {
+	return func(ws *WordSearch) {
+		ws.decoys = perWord
+	}
+}
+
+// addDecoys writes decoys for every placed word into the filler
+func (ws *WordSearch) addDecoys(ctx context.Context) {
+	if len(ws.placements) == 0 {
+		return
+	}
+	var words []string
+	for _, p := range ws.placements {
+		words = append(words, p.Word)
+	}
+	before := len(search(ws.Grid, words, vector.Cardinals, letters.Upper))
+
+	for range ws.decoys {
+		for _, p := range ws.placements {
+			if ctx.Err() != nil {
+				return
+			}
+			decoy := ws.decoyFor([]rune(p.Word))
+			if decoy == nil {
+				continue
+			}
+			cells, previous := ws.writeDecoy(decoy)
+			if cells == nil {
+				continue
+			}
+			// put the filler back if the decoy accidentally spelled out a hidden word
+			if len(search(ws.Grid, words, vector.Cardinals, letters.Upper)) > before {
+				for i, cell := range cells {
+					ws.Grid[cell[0]][cell[1]] = previous[i]
+				}
+			}
+		}
+	}
+}
+
+// decoyFor returns either the start of an uppercase word or a misspelling of it.
+// It returns nil if the word is too short to make a decoy from.
+func (ws *WordSearch) decoyFor(word []rune) []rune {
+	if len(word) < 3 {
+		return nil
+	}
+	if ws.rng.Intn(2) == 0 {
+		// the first letters of the word, but never all of them
+		return append([]rune(nil), word[:2+ws.rng.Intn(len(word)-2)]...)
+	}
+	// the whole word, with one letter replaced by a different one from the alphabet
+	decoy := append([]rune(nil), word...)
+	i := ws.rng.Intn(len(decoy))
+	for range attempts {
+		r := ws.alphabet[ws.rng.Intn(len(ws.alphabet))]
+		if r != word[i] {
+			decoy[i] = r
+			return decoy
+		}
+	}
+	return nil
+}
+
+// writeDecoy writes a decoy in lowercase onto filler cells, in a random position and allowed direction.
+// It returns the cells it used and the letters that were there before, or nil if it couldn't find room.
+func (ws *WordSearch) writeDecoy(decoy []rune) (cells [][2]int, previous []rune) {
+	cands := ws.candidates(len(decoy), ws.Directions)
+	for range attempts {
+		if len(cands) == 0 {
+			return nil, nil
+		}
+		cand := cands[ws.rng.Intn(len(cands))]
+		cells = cand.cells(len(decoy))
+		fits := true
+		for _, cell := range cells {
+			if ws.cells[cell[0]][cell[1]].Kind != CellFiller {
+				fits = false
+				break
+			}
+		}
+		if !fits {
+			continue
+		}
+		previous = make([]rune, len(cells))
+		for i, cell := range cells {
+			previous[i] = ws.Grid[cell[0]][cell[1]]
+			ws.Grid[cell[0]][cell[1]] = letters.ToLowercase(decoy[i])
+		}
+		return cells, previous
+	}
+	return nil, nil
+}
@@ -0,0 +1,44 @@
+package wordsearch
+
+import (
+	"testing"
+)
+
+// TestWithDecoys checks that decoys add false leads without adding another copy of any word
+func TestWithDecoys(t *testing.T) {
+	words := []string{"GOPHER", "CHANNEL", "STRUCT", "SLICE", "POINTER", "MAP"}
+
+	// leads counts how many times the first two letters of each word can be found in the grid
+	leads := func(ws *WordSearch) int {
+		var prefixes []string
+		for _, word := range words {
+			prefixes = append(prefixes, word[:2])
+		}
+		return len(ws.Solve(prefixes))
+	}
+
+	withoutDecoys, withDecoys := 0, 0
+	for seed := range int64(5) {
+		ws := newWordSearch(t, 15, WithSeed(seed), WithUniqueWords())
+		ws.CreatePuzzle(words)
+		withoutDecoys += leads(ws)
+
+		ws = newWordSearch(t, 15, WithSeed(seed), WithUniqueWords(), WithDecoys(3))
+		if unplaced := ws.CreatePuzzle(words); len(unplaced) > 0 {
+			t.Fatalf("seed %d: expected no unplaced, got %v", seed, unplaced)
+		}
+		if err := ws.Err(); err != nil {
+			t.Fatalf("seed %d: expected no error, got %v", seed, err)
+		}
+		for _, word := range words {
+			if found := ws.Solve([]string{word}); len(found) != 1 {
+				t.Errorf("seed %d: expected %s exactly once, found it %d times", seed, word, len(found))
+			}
+		}
+		withDecoys += leads(ws)
+	}
+	t.Logf("false leads: %d without decoys, %d with decoys", withoutDecoys, withDecoys)
+	if withDecoys <= withoutDecoys {
+		t.Errorf("expected more false leads with decoys, got %d vs %d", withDecoys, withoutDecoys)
+	}
+}
@@ -0,0 +1,46 @@
+package wordsearch
+
+import (
+	"math"
+	"sort"
+)
+
+// The WithOverlapPreference option makes CreatePuzzle favor positions where a word shares letters
+// with words that have already been placed, so the words cross each other as much as possible.
+// The aggressiveness is how hard it chases overlaps: a position that shares n letters is
+// (n+1)^aggressiveness times more likely to be tried first than one that shares none.
+// Zero means no preference, 1 is a gentle nudge, and 5 or more almost always takes the best spot.
+// It has no effect if overlaps are disallowed.
+func WithOverlapPreference(aggressiveness float64) Option {
+	return func(ws *WordSearch) {
+		ws.overlapPreference = aggressiveness
+	}
+}
+
+// rankByOverlap puts the candidates into a weighted random order, based on how many letters
+// each one would share with words already in the grid. Candidates where the word can't be placed are left out.
+func (ws *WordSearch) rankByOverlap(word []rune, cands []candidate) []candidate {
+	type ranked struct {
+		cand candidate
+		key  float64
+	}
+	var ranks []ranked
+	for _, cand := range cands {
+		shared, err := ws.checkPlacement(word, cand)
+		if err != nil {
+			continue
+		}
+		weight := math.Pow(float64(shared+1), ws.overlapPreference)
+		// a weighted random shuffle: each key is an exponential random number with a rate equal to the weight
+		key := ws.rng.ExpFloat64() / weight
+		ranks = append(ranks, ranked{cand: cand, key: key})
+	}
+	sort.SliceStable(ranks, func(i, j int) bool {
+		return ranks[i].key < ranks[j].key
+	})
+	ordered := make([]candidate, len(ranks))
+	for i := range ranks {
+		ordered[i] = ranks[i].cand
+	}
+	return ordered
+}
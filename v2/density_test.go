@@ -0,0 +1,47 @@
+package wordsearch
+
+import (
+	"testing"
+)
+
+// sharedCells is a private function that counts how many letters in the grid belong to more than one placed word
+func sharedCells(ws *WordSearch) int {
+	total := 0
+	cells := make(map[[2]int]bool)
+	for _, p := range ws.Placements() {
+		for _, cell := range p.cells() {
+			total++
+			cells[cell] = true
+		}
+	}
+	return total - len(cells)
+}
+
+// TestWithOverlapPreference checks that favoring overlaps produces more crossings than placing words at random
+func TestWithOverlapPreference(t *testing.T) {
+	words := []string{"GOPHER", "GOROUTINE", "CHANNEL", "INTERFACE", "POINTER", "STRUCT", "SLICE", "RUNE", "MAP", "DEFER"}
+
+	overlaps := func(opt ...Option) (total int) {
+		for seed := range int64(10) {
+			ws := newWordSearch(t, 12, append(opt, WithSeed(seed))...)
+			if unplaced := ws.CreatePuzzle(words); len(unplaced) > 0 {
+				t.Errorf("seed %d: expected no unplaced, got %v", seed, unplaced)
+			}
+			total += sharedCells(ws)
+		}
+		return total
+	}
+
+	random := overlaps()
+	preferred := overlaps(WithOverlapPreference(5))
+	t.Logf("shared cells: %d at random, %d with a preference", random, preferred)
+	if preferred <= random {
+		t.Errorf("expected more shared cells with an overlap preference, got %d vs %d at random", preferred, random)
+	}
+
+	t.Run("no effect without overlaps", func(t *testing.T) {
+		if shared := overlaps(WithOverlapPreference(5), WithoutOverlaps()); shared != 0 {
+			t.Errorf("expected no shared cells, got %d", shared)
+		}
+	})
+}
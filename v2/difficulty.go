@@ -0,0 +1,149 @@
+package wordsearch
+
+import (
+	"github.com/rahji/wordsearch/v2/internal/letters"
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// Difficulty is an enum-like list of preset difficulty levels for WithDifficulty
+type Difficulty int
+
+const (
+	Easy   Difficulty = iota // forward words across and down, with random filler
+	Medium                   // some backward and diagonal words, gentle overlaps, and English-like filler
+	Hard                     // every direction, half the words backward, lots of overlaps, and filler full of decoys
+)
+
+// The WithBackwardRatio option sets the share of words (from 0 to 1) that should be placed reading backwards,
+// meaning right to left or bottom to top. A word goes the other way if it can't fit in the direction it was
+// given. It has no effect unless the allowed directions include both forward and backward ones.
+func WithBackwardRatio(ratio float64) Option {
+	return func(ws *WordSearch) {
+		ws.backwardRatio = min(max(ratio, 0), 1)
+	}
+}
+
+// The WithDifficulty option configures the allowed directions, backward ratio, overlap preference
+// and filler strategy all at once. Options that come after it can change any of those settings.
+//   - Easy: words only go E and S, they never read backwards, and the filler is random
+//   - Medium: words also go NE, SE, W and N, a quarter of them read backwards, they cross a little more
+//     than usual, and the filler uses English letter frequencies (unless the alphabet has no English letters)
+//   - Hard: words go in every direction, half of them read backwards, they cross as much as possible,
+//     and the filler camouflages the words and hides three decoys per word
+func WithDifficulty(difficulty Difficulty) Option {
+	return func(ws *WordSearch) {
+		ws.frequencies = nil
+		ws.camouflage = false
+		ws.decoys = 0
+		switch difficulty {
+		case Easy:
+			ws.Directions = []string{"E", "S"}
+			ws.backwardRatio = 0
+			ws.overlapPreference = 0
+		case Medium:
+			ws.Directions = []string{"NE", "E", "SE", "S", "W", "N"}
+			ws.backwardRatio = 0.25
+			ws.overlapPreference = 1
+			ws.frequencies = newFillerTable(englishFrequencies)
+		case Hard:
+			ws.Directions = append([]string(nil), vector.Cardinals...)
+			ws.backwardRatio = 0.5
+			ws.overlapPreference = 5
+			ws.camouflage = true
+			ws.decoys = 3
+		}
+	}
+}
+
+// preferredDirections picks the groups of directions that the next word should try, in order, before
+// trying any direction. They're based on the direction weights or, if there aren't any, the backward ratio.
+// It returns nil if there's no preference.
+func (ws *WordSearch) preferredDirections() [][]string {
+	if ws.directionWeights != nil {
+		return ws.neediestDirections()
+	}
+	if ws.backwardRatio < 0 {
+		return nil
+	}
+	var forward, backward []string
+	for _, cardinal := range ws.Directions {
+		if vector.IsBackward(cardinal) {
+			backward = append(backward, cardinal)
+		} else {
+			forward = append(forward, cardinal)
+		}
+	}
+	if len(forward) == 0 || len(backward) == 0 {
+		return nil
+	}
+	if ws.rng.Float64() < ws.backwardRatio {
+		return [][]string{backward}
+	}
+	return [][]string{forward}
+}
+
+// DifficultyScore estimates how hard the puzzle is to solve, from 0 (easiest) to 1 (hardest).
+// It looks at the words that have been placed and the grid around them:
+//   - the share of words that read backwards, and the share that run diagonally
+//   - how much the words cross each other, since shared letters are harder to spot
+//   - how much of the grid is filler to search through
+//   - how many false leads there are, meaning the first two letters of a word showing up where the word isn't
+//
+// It returns 0 if no words have been placed.
+func (ws *WordSearch) DifficultyScore() float64 {
+	if len(ws.placements) == 0 {
+		return 0
+	}
+	words := float64(len(ws.placements))
+
+	var backward, diagonal, letterCount float64
+	var prefixes []string
+	for _, p := range ws.placements {
+		if vector.IsBackward(p.Cardinal) {
+			backward++
+		}
+		if vector.IsDiagonal(p.Cardinal) {
+			diagonal++
+		}
+		runes := []rune(p.Word)
+		letterCount += float64(len(runes))
+		if len(runes) > 1 {
+			prefixes = append(prefixes, string(runes[:2]))
+		}
+	}
+
+	var filler, placed float64
+	for r := range ws.cells {
+		for c := range ws.cells[r] {
+			switch ws.cells[r][c].Kind {
+			case CellFiller:
+				filler++
+			case CellPlaced:
+				placed++
+			}
+		}
+	}
+	// the share of letters that land on a cell used by another word, where a half is about as much as is possible
+	overlap := min(2*(letterCount-placed)/letterCount, 1)
+	fillerShare := filler / (filler + placed)
+	// every placed word is one lead that isn't false
+	leads := max(float64(len(search(ws.Grid, prefixes, vector.Cardinals, letters.Upper)))-float64(len(prefixes)), 0) / words
+	falseLeads := leads / (leads + 4)
+
+	return 0.3*backward/words + 0.2*diagonal/words + 0.15*overlap + 0.1*fillerShare + 0.25*falseLeads
+}
+
+// EstimateDifficulty turns the DifficultyScore of the puzzle into the closest Difficulty preset.
+// The boundaries between levels come from scoring lots of puzzles made with each preset, but any
+// single puzzle can land on the other side of one, especially if it only has a few words.
+func (ws *WordSearch) EstimateDifficulty() Difficulty {
+	score := ws.DifficultyScore()
+	switch {
+	case score < 0.22:
+		return Easy
+	case score < 0.4:
+		return Medium
+	default:
+		return Hard
+	}
+}
@@ -0,0 +1,95 @@
+package wordsearch
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// difficultyWords is a private list of words for puzzles at every difficulty
+var difficultyWords = []string{"GOPHER", "CHANNEL", "STRUCT", "SLICE", "POINTER", "RUNE", "DEFER", "PANIC", "CLOSURE", "MUTEX"}
+
+// TestWithBackwardRatio checks that roughly the right share of words read backwards
+func TestWithBackwardRatio(t *testing.T) {
+	for _, ratio := range []float64{0, 0.5, 1} {
+		backward, total := 0, 0
+		for seed := range int64(10) {
+			ws := newWordSearch(t, 15, WithSeed(seed), WithBackwardRatio(ratio))
+			ws.CreatePuzzle(difficultyWords)
+			for _, p := range ws.Placements() {
+				total++
+				if vector.IsBackward(p.Cardinal) {
+					backward++
+				}
+			}
+		}
+		share := float64(backward) / float64(total)
+		t.Logf("ratio %.1f: %d of %d words backward", ratio, backward, total)
+		if share < ratio-0.15 || share > ratio+0.15 {
+			t.Errorf("ratio %.1f: expected about that share of backward words, got %.2f", ratio, share)
+		}
+	}
+}
+
+// TestWithDifficulty checks that the presets score in order and are usually estimated correctly
+func TestWithDifficulty(t *testing.T) {
+	previous := -1.0
+	for _, difficulty := range []Difficulty{Easy, Medium, Hard} {
+		total := 0.0
+		wrong := 0
+		for seed := range int64(5) {
+			ws := newWordSearch(t, 14, WithSeed(seed), WithDifficulty(difficulty))
+			if unplaced := ws.CreatePuzzle(difficultyWords); len(unplaced) > 0 {
+				t.Fatalf("difficulty %d, seed %d: expected no unplaced, got %v", difficulty, seed, unplaced)
+			}
+			if got := ws.EstimateDifficulty(); got != difficulty {
+				t.Logf("difficulty %d, seed %d: estimated %d with a score of %.2f", difficulty, seed, got, ws.DifficultyScore())
+				wrong++
+			}
+			total += ws.DifficultyScore()
+		}
+		if wrong > 1 {
+			t.Errorf("difficulty %d: expected at most 1 wrong estimate out of 5, got %d", difficulty, wrong)
+		}
+		average := total / 5
+		t.Logf("difficulty %d: average score %.2f", difficulty, average)
+		if average <= previous {
+			t.Errorf("difficulty %d: expected a higher score than the previous level, got %.2f", difficulty, average)
+		}
+		previous = average
+	}
+
+	t.Run("easy puzzles only go forward across and down", func(t *testing.T) {
+		ws := newWordSearch(t, 14, WithDifficulty(Easy))
+		ws.CreatePuzzle(difficultyWords)
+		for _, p := range ws.Placements() {
+			if p.Cardinal != "E" && p.Cardinal != "S" {
+				t.Errorf("expected E or S, got %s for %s", p.Cardinal, p.Word)
+			}
+		}
+	})
+
+	t.Run("medium filler stays in a non-Latin alphabet", func(t *testing.T) {
+		const greek = "ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ"
+		for _, opts := range [][]Option{
+			{WithAlphabet(greek), WithDifficulty(Medium)},
+			{WithDifficulty(Medium), WithAlphabet(greek)},
+		} {
+			ws := newWordSearch(t, 6, append(opts, WithSeed(1))...)
+			ws.CreatePuzzle([]string{"ΗΛΙΟΣ"})
+			for r := range countFiller(ws) {
+				if !strings.ContainsRune(strings.ToLower(greek), r) {
+					t.Errorf("expected Greek filler, got %c", r)
+				}
+			}
+		}
+	})
+
+	t.Run("later options override the preset", func(t *testing.T) {
+		ws := newWordSearch(t, 14, WithDifficulty(Hard), WithDirections([]string{"E"}))
+		if len(ws.Directions) != 1 || ws.Directions[0] != "E" {
+			t.Errorf("expected only E, got %v", ws.Directions)
+		}
+	})
+}
@@ -0,0 +1,291 @@
+package wordsearch
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/rand"
+	"slices"
+	"unicode"
+
+	"github.com/rahji/wordsearch/v2/internal/letters"
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// maxRerolls is the max number of passes made over the grid while re-rolling unwanted filler letters
+const maxRerolls = 1000
+
+// ErrDuplicateWord means a hidden word appears in the grid more than once and re-rolling
+// the filler letters could not fix it, because the extra copy is made entirely of placed letters
+var ErrDuplicateWord = errors.New("a word appears in the grid more than once")
+
+// ErrBlockedWord means a word from the blocklist appears in the grid and re-rolling
+// the filler letters could not fix it, because it is made entirely of placed letters
+var ErrBlockedWord = errors.New("a blocked word appears in the grid")
+
+// ErrFillerNotClean means re-rolling the filler letters kept spelling a duplicate or blocked word,
+// which can happen with a small alphabet, and CreatePuzzle gave up
+var ErrFillerNotClean = errors.New("could not clean up the filler letters")
+
+// The WithUniqueWords option makes sure that each placed word can be found exactly once
+// in the finished puzzle, reading in any of the allowed directions. Filler letters that
+// accidentally spell a placed word (or spell it backwards) are re-rolled by CreatePuzzle.
+func WithUniqueWords() Option {
+	return func(ws *WordSearch) {
+		ws.unique = true
+	}
+}
+
+// The WithBlocklist option takes a list of words that must never appear in the finished puzzle,
+// reading in any of the eight directions. Blocked words are normalized the same way as the words
+// for CreatePuzzle (see NormalizeWord). Filler letters that spell a blocked word are re-rolled
+// by CreatePuzzle. If a blocked word is made entirely of placed letters, Err reports ErrBlockedWord.
+func WithBlocklist(words []string) Option {
+	return func(ws *WordSearch) {
+		// normalized by NewWordSearch, once the alphabet is known
+		ws.blocklist = append([]string(nil), words...)
+	}
+}
+
+// The WithAlphabet option sets the letters used for filler, for puzzles in languages other than English.
+// For example, a Spanish puzzle might use "ABCDEFGHIJKLMNÑOPQRSTUVWXYZ" and a Greek puzzle "ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ".
+// Letters can be given in either case, and anything that isn't a letter is ignored.
+// The default is A-Z. NewWordSearch returns ErrEmptyAlphabet if there are no letters.
+func WithAlphabet(alphabet string) Option {
+	return func(ws *WordSearch) {
+		ws.alphabet = []rune{}
+		seen := make(map[rune]bool)
+		for _, r := range letters.Upper(alphabet) {
+			if unicode.IsLetter(r) && !seen[r] {
+				seen[r] = true
+				ws.alphabet = append(ws.alphabet, r)
+			}
+		}
+	}
+}
+
+// englishFrequencies is how often each letter is used in English text, as a percentage
+var englishFrequencies = map[rune]float64{
+	'A': 8.2, 'B': 1.5, 'C': 2.8, 'D': 4.3, 'E': 12.7, 'F': 2.2, 'G': 2.0, 'H': 6.1, 'I': 7.0,
+	'J': 0.15, 'K': 0.77, 'L': 4.0, 'M': 2.4, 'N': 6.7, 'O': 7.5, 'P': 1.9, 'Q': 0.095, 'R': 6.0,
+	'S': 6.3, 'T': 9.1, 'U': 2.8, 'V': 0.98, 'W': 2.4, 'X': 0.15, 'Y': 2.0, 'Z': 0.074,
+}
+
+// The WithLetterFrequencies option picks filler letters according to a table of how often each letter
+// should appear, instead of picking every letter of the alphabet equally often. The frequencies can be
+// percentages, counts, or any other relative weights. Letters with a weight of zero or less, letters
+// that aren't in the alphabet (see WithAlphabet), and anything that isn't a letter are never used.
+// If no letter of the alphabet has a positive weight, the alphabet is used as usual.
+func WithLetterFrequencies(frequencies map[rune]float64) Option {
+	return func(ws *WordSearch) {
+		ws.frequencies = newFillerTable(frequencies)
+	}
+}
+
+// The WithEnglishFrequencies option picks filler letters according to how often they are used in English,
+// so rare letters like Q, X and Z don't stand out any more than they would in a real word.
+func WithEnglishFrequencies() Option {
+	return WithLetterFrequencies(englishFrequencies)
+}
+
+// The WithCamouflageFiller option makes CreatePuzzle replace the filler once the words are placed,
+// picking letters as often as they appear in the placed words. Letters in the placed words that aren't
+// in the alphabet are left out. The camouflage is added on top of the usual filler (the alphabet,
+// or the table from WithLetterFrequencies), which counts as one extra count per letter of the alphabet
+// so that no letter is left out entirely. The usual filler is still used for any letters re-rolled afterwards.
+func WithCamouflageFiller() Option {
+	return func(ws *WordSearch) {
+		ws.camouflage = true
+	}
+}
+
+// fillerTable is a weighted list of letters to pick filler from
+type fillerTable struct {
+	letters    []rune
+	cumulative []float64 // the running total of the weights, in the same order as the letters
+}
+
+// newFillerTable builds a filler table from a map of letters to weights. Keys that aren't letters are ignored,
+// the same as in WithAlphabet. It returns nil if no letter has a positive weight.
+func newFillerTable(weights map[rune]float64) *fillerTable {
+	// combine upper and lowercase keys, then sort them so the same seed always gives the same filler
+	combined := make(map[rune]float64)
+	for r, weight := range weights {
+		if weight > 0 && unicode.IsLetter(r) {
+			combined[letters.ToUppercase(r)] += weight
+		}
+	}
+	if len(combined) == 0 {
+		return nil
+	}
+	table := new(fillerTable)
+	for r := range combined {
+		table.letters = append(table.letters, r)
+	}
+	slices.Sort(table.letters)
+	total := 0.0
+	for _, r := range table.letters {
+		total += combined[r]
+		table.cumulative = append(table.cumulative, total)
+	}
+	return table
+}
+
+// weights returns the weight of each letter in the table, scaled so they add up to total
+func (table *fillerTable) weights(total float64) map[rune]float64 {
+	weights := make(map[rune]float64)
+	scale := total / table.cumulative[len(table.cumulative)-1]
+	previous := 0.0
+	for i, r := range table.letters {
+		weights[r] = (table.cumulative[i] - previous) * scale
+		previous = table.cumulative[i]
+	}
+	return weights
+}
+
+// within returns a copy of the table with only the letters in the alphabet, or nil if none of them are
+func (table *fillerTable) within(alphabet []rune) *fillerTable {
+	if table == nil {
+		return nil
+	}
+	weights := table.weights(1)
+	for r := range weights {
+		if !slices.Contains(alphabet, r) {
+			delete(weights, r)
+		}
+	}
+	return newFillerTable(weights)
+}
+
+// pick returns a random uppercase letter from the table
+func (table *fillerTable) pick(rng *rand.Rand) rune {
+	target := rng.Float64() * table.cumulative[len(table.cumulative)-1]
+	i, _ := slices.BinarySearch(table.cumulative, target)
+	return table.letters[min(i, len(table.letters)-1)]
+}
+
+// randomFiller returns a random lowercase letter, either from the table of letter frequencies or from the alphabet.
+// Lowercase letters represent letters that were not placed intentionally.
+func (ws *WordSearch) randomFiller() rune {
+	if ws.frequencies != nil {
+		return letters.ToLowercase(ws.frequencies.pick(ws.rng))
+	}
+	return letters.ToLowercase(ws.alphabet[ws.rng.Intn(len(ws.alphabet))])
+}
+
+// camouflageFiller replaces every filler letter using the frequencies of the letters in the placed words,
+// on top of the usual filler. It leaves the usual filler table alone.
+func (ws *WordSearch) camouflageFiller() {
+	counts := make(map[rune]float64)
+	if ws.frequencies != nil {
+		counts = ws.frequencies.weights(float64(len(ws.alphabet)))
+	} else {
+		for _, r := range ws.alphabet {
+			counts[r] = 1
+		}
+	}
+	for _, p := range ws.placements {
+		for _, r := range p.Word {
+			if slices.Contains(ws.alphabet, r) {
+				counts[r]++
+			}
+		}
+	}
+	table := newFillerTable(counts)
+	for r := range ws.Grid {
+		for c := range ws.Grid[r] {
+			if ws.cells[r][c].Kind == CellFiller {
+				ws.Grid[r][c] = letters.ToLowercase(table.pick(ws.rng))
+			}
+		}
+	}
+}
+
+// cleanFiller keeps re-rolling filler letters that are part of an unwanted sequence of letters
+// until there are none left. It gives up if an unwanted sequence has no filler letters in it,
+// or if the context is done.
+func (ws *WordSearch) cleanFiller(ctx context.Context) error {
+	for range maxRerolls {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		unwanted, err := ws.unwantedOccurrences()
+		if err != nil {
+			return err
+		}
+		if len(unwanted) == 0 {
+			return nil
+		}
+		for _, cells := range unwanted {
+			// only one letter needs to change to break up the sequence
+			cell := cells[ws.rng.Intn(len(cells))]
+			ws.Grid[cell[0]][cell[1]] = ws.randomFiller()
+		}
+	}
+	return ErrFillerNotClean
+}
+
+// unwantedOccurrences returns the filler cells of each sequence of letters in the grid that shouldn't be there.
+// If one of those sequences doesn't contain any filler, it returns an error instead.
+func (ws *WordSearch) unwantedOccurrences() (unwanted [][][2]int, err error) {
+	for _, occurrence := range search(ws.Grid, ws.blocklist, vector.Cardinals, letters.Upper) {
+		cells := ws.fillerCells(occurrence)
+		if len(cells) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrBlockedWord, occurrence.Word)
+		}
+		unwanted = append(unwanted, cells)
+	}
+
+	if !ws.unique {
+		return unwanted, nil
+	}
+
+	// every distinct word that was placed
+	var words []string
+	seen := make(map[string]bool)
+	for _, p := range ws.placements {
+		if !seen[p.Word] {
+			seen[p.Word] = true
+			words = append(words, p.Word)
+		}
+	}
+
+	for _, occurrence := range search(ws.Grid, words, ws.Directions, letters.Upper) {
+		if ws.isPlacement(occurrence) {
+			continue
+		}
+		cells := ws.fillerCells(occurrence)
+		if len(cells) == 0 {
+			return nil, fmt.Errorf("%w: %s", ErrDuplicateWord, occurrence.Word)
+		}
+		unwanted = append(unwanted, cells)
+	}
+	return unwanted, nil
+}
+
+// isPlacement returns true if an occurrence of a word covers exactly the same cells as
+// a word that was placed. A palindrome can be read either way, but it is still only one word.
+func (ws *WordSearch) isPlacement(occurrence Placement) bool {
+	for _, p := range ws.placements {
+		if p.Word != occurrence.Word {
+			continue
+		}
+		if p.Row == occurrence.Row && p.Col == occurrence.Col && p.EndRow == occurrence.EndRow && p.EndCol == occurrence.EndCol {
+			return true
+		}
+		if p.Row == occurrence.EndRow && p.Col == occurrence.EndCol && p.EndRow == occurrence.Row && p.EndCol == occurrence.Col {
+			return true
+		}
+	}
+	return false
+}
+
+// fillerCells returns the row and column of each cell in an occurrence that holds a filler letter
+func (ws *WordSearch) fillerCells(occurrence Placement) (cells [][2]int) {
+	for _, cell := range occurrence.cells() {
+		if ws.cells[cell[0]][cell[1]].Kind == CellFiller {
+			cells = append(cells, cell)
+		}
+	}
+	return cells
+}
@@ -0,0 +1,159 @@
+package wordsearch
+
+import (
+	"errors"
+	"testing"
+)
+
+// TestWithUniqueWords fills a grid with accidental copies of a word and checks that only the placed one survives
+func TestWithUniqueWords(t *testing.T) {
+	ws := newWordSearch(t, 6, WithUniqueWords(), WithSeed(1))
+	for i := range ws.Grid {
+		// "cat" and its reverse "tac" in every direction, over and over
+		copy(ws.Grid[i], []rune("cattac"))
+	}
+	unplaced := ws.CreatePuzzle([]string{"CAT"})
+	if len(unplaced) > 0 {
+		t.Fatalf("expected CAT to be placed, got unplaced %v", unplaced)
+	}
+	if err := ws.Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found := ws.Solve([]string{"CAT"}); len(found) != 1 {
+		t.Errorf("expected CAT exactly once, found %d times: %+v", len(found), found)
+	}
+	printGrid(t, ws.ReturnGrid(GridRaw))
+
+	t.Run("a copy made of placed letters can't be fixed", func(t *testing.T) {
+		ws := newWordSearch(t, 6, WithUniqueWords(), WithSeed(1), WithDirections([]string{"E"}))
+		ws.CreatePuzzle([]string{"CATS", "CAT"})
+		if !errors.Is(ws.Err(), ErrDuplicateWord) {
+			t.Errorf("expected ErrDuplicateWord, got %v", ws.Err())
+		}
+	})
+
+	t.Run("a palindrome counts once", func(t *testing.T) {
+		ws := newWordSearch(t, 6, WithUniqueWords(), WithSeed(1))
+		ws.CreatePuzzle([]string{"LEVEL"})
+		if err := ws.Err(); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+	})
+}
+
+// TestWithBlocklist fills a grid with a blocked word and checks that the filler gets cleaned up
+func TestWithBlocklist(t *testing.T) {
+	ws := newWordSearch(t, 6, WithBlocklist([]string{"bad", "Rude"}), WithSeed(3))
+	for i := range ws.Grid {
+		copy(ws.Grid[i], []rune("badrude"))
+	}
+	unplaced := ws.CreatePuzzle([]string{"GOOD", "NICE"})
+	if len(unplaced) > 0 {
+		t.Fatalf("expected every word to be placed, got unplaced %v", unplaced)
+	}
+	if err := ws.Err(); err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if found := ws.Solve([]string{"BAD", "RUDE"}); len(found) > 0 {
+		t.Errorf("expected no blocked words, found %+v", found)
+	}
+	printGrid(t, ws.ReturnGrid(GridRaw))
+
+	t.Run("a blocked word made of placed letters can't be fixed", func(t *testing.T) {
+		ws := newWordSearch(t, 6, WithBlocklist([]string{"ASS"}), WithSeed(3))
+		ws.CreatePuzzle([]string{"CLASS"})
+		if !errors.Is(ws.Err(), ErrBlockedWord) {
+			t.Errorf("expected ErrBlockedWord, got %v", ws.Err())
+		}
+	})
+
+	t.Run("filler that can only spell a blocked word can't be fixed", func(t *testing.T) {
+		ws := newWordSearch(t, 3, WithAlphabet("A"), WithBlocklist([]string{"AA"}), WithSeed(3))
+		ws.CreatePuzzle(nil)
+		if !errors.Is(ws.Err(), ErrFillerNotClean) {
+			t.Errorf("expected ErrFillerNotClean, got %v", ws.Err())
+		}
+	})
+}
+
+// countFiller is a private function that counts each filler letter in the grid
+func countFiller(ws *WordSearch) map[rune]int {
+	counts := make(map[rune]int)
+	for r := range ws.Grid {
+		for c, letter := range ws.Grid[r] {
+			if ws.Cell(r, c).Kind == CellFiller {
+				counts[letter]++
+			}
+		}
+	}
+	return counts
+}
+
+// TestLetterFrequencies checks that filler letters follow a frequency table
+func TestLetterFrequencies(t *testing.T) {
+	t.Run("a user-supplied table", func(t *testing.T) {
+		ws := newWordSearch(t, 20, WithLetterFrequencies(map[rune]float64{'x': 1, 'Y': 3, 'Z': 0}), WithSeed(8))
+		counts := countFiller(ws)
+		if counts['x']+counts['y'] != 400 {
+			t.Errorf("expected only x and y, got %v", counts)
+		}
+		if counts['y'] < 2*counts['x'] {
+			t.Errorf("expected y about three times as often as x, got %v", counts)
+		}
+	})
+
+	t.Run("keys that aren't letters are ignored", func(t *testing.T) {
+		ws := newWordSearch(t, 10, WithLetterFrequencies(map[rune]float64{'1': 5, '-': 2}), WithSeed(8))
+		for r := range countFiller(ws) {
+			if r < 'a' || r > 'z' {
+				t.Errorf("expected filler from the alphabet, got %q", r)
+			}
+		}
+	})
+
+	t.Run("the English table", func(t *testing.T) {
+		ws := newWordSearch(t, 50, WithEnglishFrequencies(), WithSeed(8))
+		counts := countFiller(ws)
+		if counts['e'] < 10*counts['z'] || counts['e'] < 10*counts['q'] {
+			t.Errorf("expected e to be much more common than z or q, got %d, %d and %d", counts['e'], counts['z'], counts['q'])
+		}
+	})
+
+	t.Run("camouflage copies the placed words", func(t *testing.T) {
+		ws := newWordSearch(t, 30, WithCamouflageFiller(), WithSeed(8))
+		unplaced := ws.CreatePuzzle([]string{"BANANA", "BANDANA", "CABANA", "ANANAS", "NAAN", "BAHAMAS", "SAVANNA", "MANNA"})
+		if len(unplaced) > 0 {
+			t.Fatalf("expected no unplaced, got %v", unplaced)
+		}
+		counts := countFiller(ws)
+		total := 0
+		for _, n := range counts {
+			total += n
+		}
+		// without camouflage each letter would be about 1/26 of the filler
+		if counts['a'] < total/4 || counts['n'] < total/8 {
+			t.Errorf("expected lots of a and n, got %d and %d out of %d", counts['a'], counts['n'], total)
+		}
+		if counts['e'] == 0 {
+			t.Errorf("expected letters that aren't in any word to still appear sometimes")
+		}
+	})
+
+	t.Run("camouflage builds on the usual filler and keeps to the alphabet", func(t *testing.T) {
+		frequencies := map[rune]float64{'X': 1, 'Y': 1}
+		ws := newWordSearch(t, 20, WithAlphabet("ABXY"), WithLetterFrequencies(frequencies), WithCamouflageFiller(), WithSeed(8))
+		if unplaced := ws.CreatePuzzle([]string{"BABA", "A1A1"}); len(unplaced) > 0 {
+			t.Fatalf("expected no unplaced, got %v", unplaced)
+		}
+		counts := countFiller(ws)
+		if counts['1'] > 0 {
+			t.Errorf("expected no letters from outside the alphabet, got %v", counts)
+		}
+		if counts['x'] == 0 || counts['y'] == 0 || counts['a'] == 0 || counts['b'] == 0 {
+			t.Errorf("expected the table's letters and the words' letters, got %v", counts)
+		}
+		if ws.frequencies == nil || len(ws.frequencies.letters) != 2 {
+			t.Errorf("expected the table to be left alone")
+		}
+	})
+}
@@ -1,27 +1,82 @@
 package letters
 
-// IsUppercase returns true if the byte is a capital letter.
-func IsUppercase(b byte) bool {
-	return b >= 'A' && b <= 'Z'
+import "unicode"
+
+// ToLowercase turns a rune into lowercase
+func ToLowercase(r rune) rune {
+	return unicode.ToLower(r)
 }
 
-// IsLowercase returns true if the byte is a lowercase letter.
-func IsLowercase(b byte) bool {
-	return b >= 'a' && b <= 'z'
+// ToUppercase turns a rune into uppercase.
+// Unlike unicode.ToUpper, it turns ß into the capital ẞ so every lowercase letter has an uppercase twin.
+func ToUppercase(r rune) rune {
+	if r == 'ß' {
+		return 'ẞ'
+	}
+	return unicode.ToUpper(r)
 }
 
-// ToLowercase turns an byte into lowercase
-func ToLowercase(b byte) byte {
-	if b >= 'A' && b <= 'Z' {
-		return b + 32
+// Upper uppercases a string one rune at a time, so the result always has the same number of runes.
+// (strings.ToUpper turns ß into SS.)
+func Upper(s string) []rune {
+	runes := []rune(s)
+	for i, r := range runes {
+		runes[i] = ToUppercase(r)
 	}
-	return b
+	return runes
 }
 
-// ToUppercase turns a byte into uppercase
-func ToUppercase(b byte) byte {
-	if b >= 'a' && b <= 'z' {
-		return b - 32
+// accented lists uppercase letters with diacritics, keyed by what they become when the diacritics are removed
+var accented = map[string]string{
+	"A":  "ÀÁÂÃÄÅĀĂĄǍ",
+	"C":  "ÇĆĈĊČ",
+	"D":  "ĎĐÐ",
+	"E":  "ÈÉÊËĒĔĖĘĚ",
+	"G":  "ĜĞĠĢ",
+	"H":  "ĤĦ",
+	"I":  "ÌÍÎÏĨĪĬĮİǏ",
+	"J":  "Ĵ",
+	"K":  "Ķ",
+	"L":  "ĹĻĽĿŁ",
+	"N":  "ÑŃŅŇ",
+	"O":  "ÒÓÔÕÖØŌŎŐǑ",
+	"R":  "ŔŖŘ",
+	"S":  "ŚŜŞŠȘ",
+	"T":  "ŢŤŦȚ",
+	"U":  "ÙÚÛÜŨŪŬŮŰŲǓ",
+	"W":  "Ŵ",
+	"Y":  "ÝŶŸ",
+	"Z":  "ŹŻŽ",
+	"AE": "Æ",
+	"OE": "Œ",
+	"SS": "ẞ",
+	"TH": "Þ",
+	"IJ": "Ĳ",
+	"Α":  "Ά",
+	"Ε":  "Έ",
+	"Η":  "Ή",
+	"Ι":  "ΊΪ",
+	"Ο":  "Ό",
+	"Υ":  "ΎΫ",
+	"Ω":  "Ώ",
+	"Е":  "Ё",
+	"И":  "Й",
+}
+
+// plain is the reverse of accented
+var plain = func() map[rune]string {
+	m := make(map[rune]string)
+	for base, runes := range accented {
+		for _, r := range runes {
+			m[r] = base
+		}
 	}
-	return b
+	return m
+}()
+
+// StripDiacritic returns an uppercase letter without its diacritics, which can be more than one letter (Æ becomes AE).
+// The second return value is false if the letter doesn't have any diacritics that it knows how to remove.
+func StripDiacritic(r rune) (string, bool) {
+	s, ok := plain[r]
+	return s, ok
 }
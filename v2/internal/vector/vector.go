@@ -1,11 +1,47 @@
 package vector
 
+import "strings"
+
 // Vector is a private type that represents the 2 axes of a cardinal direction
 type Vector struct {
 	X int
 	Y int
 }
 
+// Cardinals lists the one- or two-letter abbreviations of all eight cardinal directions,
+// clockwise from north
+var Cardinals = []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+
+// names maps the long name of each cardinal direction (without spaces or hyphens) to its abbreviation
+var names = map[string]string{
+	"NORTH":     "N",
+	"NORTHEAST": "NE",
+	"EAST":      "E",
+	"SOUTHEAST": "SE",
+	"SOUTH":     "S",
+	"SOUTHWEST": "SW",
+	"WEST":      "W",
+	"NORTHWEST": "NW",
+}
+
+// separators removes the characters that can separate the two halves of a long name
+var separators = strings.NewReplacer("-", "", "_", "", " ", "")
+
+// ParseCardinal turns a cardinal direction into its one- or two-letter abbreviation.
+// It is case-insensitive and accepts abbreviations ("ne") as well as long names ("North-East", "northeast", "north east").
+// The second return value is false if the direction isn't recognized.
+func ParseCardinal(s string) (string, bool) {
+	s = strings.ToUpper(strings.TrimSpace(s))
+	s = separators.Replace(s)
+	for _, cardinal := range Cardinals {
+		if s == cardinal {
+			return cardinal, true
+		}
+	}
+	cardinal, ok := names[s]
+	return cardinal, ok
+}
+
 // CardinalToVector returns an xy vector for a given one- or two-letter abbreviation
 // for a cardinal direction
 func CardinalToVector(cardinal string) Vector {
@@ -30,3 +66,16 @@ func CardinalToVector(cardinal string) Vector {
 		panic("unrecognized cardinal direction")
 	}
 }
+
+// IsBackward returns true if a word in this direction reads backwards, meaning right to left
+// (W, NW, SW) or bottom to top (N)
+func IsBackward(cardinal string) bool {
+	v := CardinalToVector(cardinal)
+	return v.X < 0 || (v.X == 0 && v.Y < 0)
+}
+
+// IsDiagonal returns true if a word in this direction runs diagonally
+func IsDiagonal(cardinal string) bool {
+	v := CardinalToVector(cardinal)
+	return v.X != 0 && v.Y != 0
+}
@@ -0,0 +1,52 @@
+package wordsearch
+
+import (
+	"strings"
+)
+
+// blank is the rune used in the grid for a cell that isn't part of a shaped puzzle
+const blank = ' '
+
+// The WithMask option gives the puzzle a shape. Each true element of the mask is a cell that is part
+// of the puzzle, and each false element is a cell that is left blank. The mask is laid over the top left
+// corner of the grid, and any cell that it doesn't cover is also left blank.
+// Words are never placed on a blank cell and blank cells are never filled with letters,
+// so every style of ReturnGrid shows them as spaces.
+func WithMask(mask [][]bool) Option {
+	return func(ws *WordSearch) {
+		ws.mask = mask
+	}
+}
+
+// The WithMaskTemplate option gives the puzzle a shape using ASCII art, one line per row of the grid.
+// A space or a dot is a blank cell and any other character (including non-ASCII ones like █) is a cell
+// that is part of the puzzle.
+// Blank lines at the start and end of the template are ignored, so a raw string literal works nicely.
+// See WithMask for more details.
+func WithMaskTemplate(template string) Option {
+	lines := strings.Split(strings.Trim(template, "\r\n"), "\n")
+	mask := make([][]bool, len(lines))
+	for i, line := range lines {
+		runes := []rune(strings.TrimRight(line, "\r"))
+		mask[i] = make([]bool, len(runes))
+		for j, r := range runes {
+			mask[i][j] = r != ' ' && r != '.'
+		}
+	}
+	return WithMask(mask)
+}
+
+// applyMask blanks out every cell of the grid that the mask doesn't include
+func (ws *WordSearch) applyMask() {
+	if ws.mask == nil {
+		return
+	}
+	for r := range ws.cells {
+		for c := range ws.cells[r] {
+			if r >= len(ws.mask) || c >= len(ws.mask[r]) || !ws.mask[r][c] {
+				ws.Grid[r][c] = blank
+				ws.cells[r][c].Kind = CellBlank
+			}
+		}
+	}
+}
@@ -0,0 +1,83 @@
+package wordsearch
+
+import (
+	"testing"
+)
+
+const heart = `
+.##...##.
+#########
+#########
+.#######.
+..#####..
+...###...
+....#....
+`
+
+// TestWithMaskTemplate makes a heart-shaped puzzle and checks that the blank cells stay blank
+func TestWithMaskTemplate(t *testing.T) {
+	ws := newWordSearch(t, 9, WithMaskTemplate(heart), WithSeed(2))
+	unplaced := ws.CreatePuzzle([]string{"LOVE", "HEART", "CUPID", "ROSE"})
+	if len(unplaced) > 0 {
+		t.Errorf("expected no unplaced, got %v", unplaced)
+	}
+
+	template := []string{
+		".##...##.",
+		"#########",
+		"#########",
+		".#######.",
+		"..#####..",
+		"...###...",
+		"....#....",
+		"", // rows below the template are blank
+		"",
+	}
+	for _, style := range []GridStyle{GridRaw, GridWithDots, GridAllUppercase} {
+		grid := ws.ReturnGrid(style)
+		for r := range grid {
+			for c := range grid[r] {
+				inShape := c < len(template[r]) && template[r][c] == '#'
+				if inShape && grid[r][c] == ' ' {
+					t.Errorf("style %d [%d][%d]: expected a letter, got a blank", style, r, c)
+				}
+				if !inShape && grid[r][c] != ' ' {
+					t.Errorf("style %d [%d][%d]: expected a blank, got %c", style, r, c, grid[r][c])
+				}
+			}
+		}
+	}
+	printGrid(t, ws.ReturnGrid(GridAllUppercase))
+
+	t.Run("non-ASCII characters are one cell each", func(t *testing.T) {
+		ws := newWordSearch(t, 4, WithMaskTemplate("██.█\n.██"))
+		want := [][]bool{{true, true, false, true}, {false, true, true, false}}
+		for r := range 2 {
+			for c := range 4 {
+				if got := ws.Cell(r, c).Kind != CellBlank; got != want[r][c] {
+					t.Errorf("[%d][%d]: expected part of the puzzle to be %t, got %t", r, c, want[r][c], got)
+				}
+			}
+		}
+	})
+}
+
+// TestWithMask checks that a word can't be placed across a blank cell
+func TestWithMask(t *testing.T) {
+	mask := [][]bool{
+		{true, true, true, true},
+		{true, false, true, true},
+		{true, true, true, true},
+		{true, true, true, true},
+	}
+	ws := newWordSearch(t, 4, WithMask(mask))
+	if err := ws.PlaceWord("FOUR", 1, 0, "E"); err == nil {
+		t.Errorf("expected an error placing a word across a blank cell")
+	}
+	if err := ws.PlaceWord("FOUR", 0, 0, "SE"); err == nil {
+		t.Errorf("expected an error placing a word across a blank cell")
+	}
+	if err := ws.PlaceWord("FOUR", 2, 0, "E"); err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
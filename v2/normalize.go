@@ -0,0 +1,57 @@
+package wordsearch
+
+import (
+	"slices"
+	"unicode"
+
+	"github.com/rahji/wordsearch/v2/internal/letters"
+)
+
+// entry is a word from a words list: the letters that go in the grid, and the word the way it was given
+type entry struct {
+	word    []rune
+	display string
+}
+
+// newEntry normalizes a word for the grid, keeping the original for display
+func (ws *WordSearch) newEntry(word string) entry {
+	return entry{word: ws.normalize(word), display: word}
+}
+
+// NormalizeWord returns a word the way CreatePuzzle writes it to the grid. The word is uppercased,
+// spaces, punctuation and symbols are removed, and diacritics are removed from any letter that isn't
+// in the alphabet (see WithAlphabet), so "Crème brûlée" becomes "CREMEBRULEE" and "rock 'n' roll" becomes "ROCKNROLL".
+// Letters that aren't in the alphabet and have no plain form are left alone.
+// An empty result means the word has nothing that can be placed.
+func (ws *WordSearch) NormalizeWord(word string) string {
+	return string(ws.normalize(word))
+}
+
+func (ws *WordSearch) normalize(word string) []rune {
+	normalized := make([]rune, 0, len(word))
+	for _, r := range letters.Upper(word) {
+		switch {
+		case slices.Contains(ws.alphabet, r):
+			normalized = append(normalized, r)
+		case unicode.IsMark(r), unicode.IsSpace(r), unicode.IsPunct(r), unicode.IsSymbol(r):
+			// dropped
+		default:
+			if s, ok := letters.StripDiacritic(r); ok && ws.inAlphabet(s) {
+				normalized = append(normalized, []rune(s)...)
+			} else {
+				normalized = append(normalized, r)
+			}
+		}
+	}
+	return normalized
+}
+
+// inAlphabet reports whether every letter of s is in the alphabet
+func (ws *WordSearch) inAlphabet(s string) bool {
+	for _, r := range s {
+		if !slices.Contains(ws.alphabet, r) {
+			return false
+		}
+	}
+	return true
+}
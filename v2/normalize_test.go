@@ -0,0 +1,74 @@
+package wordsearch
+
+import (
+	"slices"
+	"testing"
+)
+
+// TestNormalizeWord checks that spaces, punctuation and diacritics are stripped, depending on the alphabet
+func TestNormalizeWord(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet string
+		word     string
+		want     string
+	}{
+		{"multiple words", "", "ice cream", "ICECREAM"},
+		{"punctuation", "", "rock 'n' roll", "ROCKNROLL"},
+		{"digits are kept", "", "R2-D2", "R2D2"},
+		{"diacritics", "", "Crème brûlée", "CREMEBRULEE"},
+		{"ligatures and sharp s", "", "Æsop's Straße", "AESOPSSTRASSE"},
+		{"combining marks", "", "nin\u0303o", "NINO"},
+		{"letters in the alphabet are kept", "ABCDEFGHIJKLMNÑOPQRSTUVWXYZ", "El Niño", "ELNIÑO"},
+		{"Greek tonos", "ΑΒΓΔΕΖΗΘΙΚΛΜΝΞΟΠΡΣΤΥΦΧΨΩ", "θάλασσα", "ΘΑΛΑΣΣΑ"},
+		{"nothing left", "", "... !?", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var opts []Option
+			if tt.alphabet != "" {
+				opts = append(opts, WithAlphabet(tt.alphabet))
+			}
+			ws := newWordSearch(t, 5, opts...)
+			if got := ws.NormalizeWord(tt.word); got != tt.want {
+				t.Errorf("NormalizeWord(%q) = %q, want %q", tt.word, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestCreatePuzzleNormalizes checks that normalized words are placed and keep their display form
+func TestCreatePuzzleNormalizes(t *testing.T) {
+	ws := newWordSearch(t, 12, WithSeed(3))
+	words := []string{"ice cream", "Crème brûlée", "!!!", "rock 'n' roll"}
+	unplaced := ws.CreatePuzzle(words)
+	if !slices.Equal(unplaced, []string{"!!!"}) {
+		t.Fatalf("expected only the empty entry to be unplaced, got %v", unplaced)
+	}
+
+	displays := make(map[string]string)
+	for _, p := range ws.Placements() {
+		displays[p.Word] = p.Display
+	}
+	want := map[string]string{
+		"CREMEBRULEE": "Crème brûlée",
+		"ROCKNROLL":   "rock 'n' roll",
+		"ICECREAM":    "ice cream",
+	}
+	for word, display := range want {
+		if displays[word] != display {
+			t.Errorf("expected %s to be displayed as %q, got %q", word, display, displays[word])
+		}
+		if found := ws.Solve([]string{display}); len(found) == 0 || found[0].Display != display {
+			t.Errorf("expected to find %q in the grid, got %v", display, found)
+		}
+	}
+}
+
+// TestBlocklistNormalizes checks that blocked words are normalized like the words for the puzzle
+func TestBlocklistNormalizes(t *testing.T) {
+	ws := newWordSearch(t, 5, WithBlocklist([]string{"T-Rex", "ice cream", "?"}))
+	if !slices.Equal(ws.blocklist, []string{"TREX", "ICECREAM"}) {
+		t.Errorf("expected the blocklist to be normalized, got %q", ws.blocklist)
+	}
+}
@@ -0,0 +1,93 @@
+package wordsearch
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"time"
+)
+
+// ErrNoRoom means there was nowhere left in the grid that a word could be placed
+var ErrNoRoom = errors.New("no room for the word")
+
+// Result describes what happened during a call to Generate
+type Result struct {
+	// Placed is every word placed by this call, in the order they were placed
+	Placed []Placement
+	// Unplaced is every word that couldn't be placed, with the reason why
+	Unplaced []Unplaced
+	// Attempts is the number of positions that were tried, counting the ones that worked
+	Attempts int
+	// Elapsed is how long it took
+	Elapsed time.Duration
+}
+
+// Unplaced is a word that Generate couldn't place, the way it was given. Reason is ErrNoRoom,
+// ErrEmptyWord (if nothing is left once the word is normalized), ErrInvalidWords (if WithValidation
+// is used), or the context's error if it was done before the word could be tried.
+type Unplaced struct {
+	Word   string
+	Reason error
+}
+
+// Generate places words from a words list, the same way as CreatePuzzleContext, and returns a Result
+// that describes where each word went and why any were left out. The words slice isn't changed.
+// The error is the same one that CreatePuzzleContext would return.
+func (ws *WordSearch) Generate(ctx context.Context, words []string) (result Result, err error) {
+	started := time.Now()
+	ws.err = nil
+	ws.attempts = 0
+	defer func() {
+		result.Attempts = ws.attempts
+		result.Elapsed = time.Since(started)
+	}()
+
+	if ws.validate {
+		if problems := ws.ValidateWords(words); problems != nil {
+			ws.err = &ValidationError{Problems: problems}
+			for _, word := range words {
+				result.Unplaced = append(result.Unplaced, Unplaced{Word: word, Reason: ErrInvalidWords})
+			}
+			return result, ws.err
+		}
+	}
+
+	var entries []entry
+	for _, word := range words {
+		e := ws.newEntry(word)
+		if len(e.word) == 0 {
+			result.Unplaced = append(result.Unplaced, Unplaced{Word: word, Reason: ErrEmptyWord})
+			continue
+		}
+		entries = append(entries, e)
+	}
+	// sort by length, longest first
+	// this is to avoid a shorter word being placed entirely within another longer word
+	sort.SliceStable(entries, func(i, j int) bool {
+		return len(entries[i].word) > len(entries[j].word)
+	})
+
+	start := len(ws.placements)
+	var missed []Unplaced
+	if ws.backtracking != nil {
+		missed = ws.placeWithBacktracking(ctx, entries)
+	} else {
+		missed = ws.placeEach(ctx, entries)
+	}
+	result.Unplaced = append(result.Unplaced, missed...)
+	result.Placed = append([]Placement(nil), ws.placements[start:]...)
+
+	if ctx.Err() == nil && ws.camouflage {
+		ws.camouflageFiller()
+	}
+	if ctx.Err() == nil && ws.decoys > 0 {
+		ws.addDecoys(ctx)
+	}
+	if ctx.Err() == nil && (ws.unique || len(ws.blocklist) > 0) {
+		ws.err = errors.Join(ws.err, ws.cleanFiller(ctx))
+	}
+	if ctx.Err() != nil {
+		ws.err = ctx.Err()
+	}
+	return result, ws.err
+}
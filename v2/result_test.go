@@ -0,0 +1,65 @@
+package wordsearch
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestGenerate checks the result of placing words, and that the words slice is left alone
+func TestGenerate(t *testing.T) {
+	ws := newWordSearch(t, 6, WithSeed(2))
+	words := []string{"CAT", "", "GOPHER", "INTERFACE", "MOUSE"}
+	original := slices.Clone(words)
+
+	result, err := ws.Generate(context.Background(), words)
+	if err != nil {
+		t.Fatalf("Generate() error = %v", err)
+	}
+	if !slices.Equal(words, original) {
+		t.Errorf("expected the words slice to be unchanged, got %v", words)
+	}
+
+	var placed []string
+	for _, p := range result.Placed {
+		placed = append(placed, p.Word)
+	}
+	if !slices.Equal(placed, []string{"GOPHER", "MOUSE", "CAT"}) {
+		t.Errorf("expected GOPHER, MOUSE and CAT to be placed longest first, got %v", placed)
+	}
+	if !slices.Equal(result.Placed, ws.Placements()) {
+		t.Errorf("expected Placed to match Placements(), got %v", result.Placed)
+	}
+
+	want := []Unplaced{{"", ErrEmptyWord}, {"INTERFACE", ErrNoRoom}}
+	if !slices.Equal(result.Unplaced, want) {
+		t.Errorf("expected unplaced %v, got %v", want, result.Unplaced)
+	}
+	if result.Attempts < len(result.Placed) {
+		t.Errorf("expected at least %d attempts, got %d", len(result.Placed), result.Attempts)
+	}
+	if result.Elapsed <= 0 {
+		t.Errorf("expected a positive elapsed time, got %v", result.Elapsed)
+	}
+}
+
+// TestGenerateCancelled checks that words not yet tried are unplaced with the context's error
+func TestGenerateCancelled(t *testing.T) {
+	ws := newWordSearch(t, 10, WithSeed(2))
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	result, err := ws.Generate(ctx, []string{"CAT", "DOG"})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+	if len(result.Placed) != 0 || len(result.Unplaced) != 2 {
+		t.Fatalf("expected nothing placed, got %+v", result)
+	}
+	for _, u := range result.Unplaced {
+		if !errors.Is(u.Reason, context.Canceled) {
+			t.Errorf("expected %s to be unplaced because of the context, got %v", u.Word, u.Reason)
+		}
+	}
+}
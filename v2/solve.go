@@ -0,0 +1,77 @@
+package wordsearch
+
+import (
+	"github.com/rahji/wordsearch/v2/internal/letters"
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// Solve searches a grid for every occurrence of each word in the list, in all eight directions.
+// The search is case-insensitive, so it works on any grid returned by ReturnGrid as well as on grids
+// that came from somewhere else. Rows don't need to be the same length.
+// Each occurrence is returned as a Placement, grouped in the order of the words list, with Display set to the word as given.
+// A palindrome is found twice, once in each direction, unless it's a single letter.
+func Solve(grid [][]rune, words []string) []Placement {
+	return search(grid, words, vector.Cardinals, letters.Upper)
+}
+
+// Solve searches the puzzle's grid for every occurrence of each word in the list.
+// Unlike the Solve function, each word is normalized the same way CreatePuzzle does it (see NormalizeWord),
+// so the words list that made the puzzle can be used to solve it. See the Solve function for details.
+func (ws *WordSearch) Solve(words []string) []Placement {
+	return search(ws.Grid, words, vector.Cardinals, ws.normalize)
+}
+
+// search finds every occurrence of each word in the grid, reading only in the given directions.
+// Each word is turned into the uppercase letters to look for by the normalize function.
+func search(grid [][]rune, words []string, cardinals []string, normalize func(string) []rune) []Placement {
+	var found []Placement
+	for _, w := range words {
+		word := normalize(w)
+		if len(word) == 0 {
+			continue
+		}
+		dirs := cardinals
+		if len(word) == 1 && len(dirs) > 1 {
+			// a single letter reads the same in every direction, so only look once
+			dirs = dirs[:1]
+		}
+		for r := range grid {
+			for c := range grid[r] {
+				if letters.ToUppercase(grid[r][c]) != word[0] {
+					continue
+				}
+				for _, cardinal := range dirs {
+					if p, ok := matchWord(grid, word, r, c, cardinal); ok {
+						p.Display = w
+						found = append(found, p)
+					}
+				}
+			}
+		}
+	}
+	return found
+}
+
+// matchWord checks whether an uppercase word appears at a specific place in the grid, reading in
+// a specific direction, and returns its Placement if it does
+func matchWord(grid [][]rune, word []rune, row int, col int, cardinal string) (Placement, bool) {
+	dir := vector.CardinalToVector(cardinal)
+	for i := 0; i < len(word); i++ {
+		r := row + i*dir.Y
+		c := col + i*dir.X
+		if r < 0 || r >= len(grid) || c < 0 || c >= len(grid[r]) {
+			return Placement{}, false
+		}
+		if letters.ToUppercase(grid[r][c]) != word[i] {
+			return Placement{}, false
+		}
+	}
+	return Placement{
+		Word:     string(word),
+		Row:      row,
+		Col:      col,
+		Cardinal: cardinal,
+		EndRow:   row + (len(word)-1)*dir.Y,
+		EndCol:   col + (len(word)-1)*dir.X,
+	}, true
+}
@@ -0,0 +1,83 @@
+package wordsearch
+
+import (
+	"testing"
+)
+
+// TestSolve searches a hand-made grid for words in several directions
+func TestSolve(t *testing.T) {
+	grid := [][]rune{
+		[]rune("catx"),
+		[]rune("aXoo"),
+		[]rune("tGOD"),
+		[]rune("dogz"),
+	}
+
+	tests := []struct {
+		name string
+		word string
+		want []Placement
+	}{
+		{
+			name: "word running east, found case-insensitively",
+			word: "Cat",
+			want: []Placement{
+				{Word: "CAT", Display: "Cat", Row: 0, Col: 0, Cardinal: "E", EndRow: 0, EndCol: 2},
+				{Word: "CAT", Display: "Cat", Row: 0, Col: 0, Cardinal: "S", EndRow: 2, EndCol: 0},
+			},
+		},
+		{
+			name: "word found in three directions",
+			word: "DOG",
+			want: []Placement{
+				{Word: "DOG", Display: "DOG", Row: 2, Col: 3, Cardinal: "W", EndRow: 2, EndCol: 1},
+				{Word: "DOG", Display: "DOG", Row: 3, Col: 0, Cardinal: "E", EndRow: 3, EndCol: 2},
+			},
+		},
+		{
+			name: "diagonal word",
+			word: "cxo",
+			want: []Placement{
+				{Word: "CXO", Display: "cxo", Row: 0, Col: 0, Cardinal: "SE", EndRow: 2, EndCol: 2},
+			},
+		},
+		{
+			name: "word that isn't there",
+			word: "BIRD",
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Solve(grid, []string{tt.word})
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d occurrences, got %d: %+v", len(tt.want), len(got), got)
+			}
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("occurrence %d: expected %+v, got %+v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+// TestSolveCreatedPuzzle checks that the solver finds every word placed by CreatePuzzle
+func TestSolveCreatedPuzzle(t *testing.T) {
+	ws := newWordSearch(t, 12, WithSeed(7))
+	words := []string{"GOPHER", "CHANNEL", "SLICE", "STRUCT", "MAP"}
+	ws.CreatePuzzle(words)
+
+	for _, p := range ws.Placements() {
+		found := false
+		for _, occurrence := range Solve(ws.ReturnGrid(GridAllLowercase), []string{p.Word}) {
+			if occurrence == p {
+				found = true
+			}
+		}
+		if !found {
+			t.Errorf("expected to find %+v", p)
+		}
+	}
+}
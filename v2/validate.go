@@ -0,0 +1,196 @@
+package wordsearch
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/rahji/wordsearch/v2/internal/vector"
+)
+
+// ErrInvalidWords means CreatePuzzle refused to run because the words list has problems (see WithValidation)
+var ErrInvalidWords = errors.New("the words list is invalid")
+
+// Problem is something wrong with a word in a words list, as found by ValidateWords
+type Problem int
+
+const (
+	ProblemEmpty        Problem = iota // nothing is left once the word is normalized
+	ProblemTooLong                     // too long to fit in the grid (around any blank cells) in any of the allowed directions
+	ProblemInvalidChars                // has characters that aren't in the alphabet
+	ProblemDuplicate                   // the same as an earlier word, once both are normalized
+	ProblemContained                   // found inside another word (or inside it backwards, if words can be read both ways)
+	ProblemPalindrome                  // reads the same backwards, so it can be found twice if words can be read both ways
+)
+
+func (p Problem) String() string {
+	switch p {
+	case ProblemEmpty:
+		return "empty"
+	case ProblemTooLong:
+		return "too long"
+	case ProblemInvalidChars:
+		return "invalid characters"
+	case ProblemDuplicate:
+		return "duplicate"
+	case ProblemContained:
+		return "contained in another word"
+	case ProblemPalindrome:
+		return "palindrome"
+	default:
+		return fmt.Sprintf("Problem(%d)", int(p))
+	}
+}
+
+// WordProblem is a problem with one word from a words list. Word is the word as it was given.
+// For ProblemDuplicate and ProblemContained, Other is the word it duplicates or is contained in.
+// For ProblemInvalidChars, Chars lists the characters that aren't in the alphabet.
+type WordProblem struct {
+	Word    string
+	Problem Problem
+	Other   string
+	Chars   []rune
+}
+
+func (wp WordProblem) String() string {
+	switch wp.Problem {
+	case ProblemDuplicate, ProblemContained:
+		return fmt.Sprintf("%q: %s (%q)", wp.Word, wp.Problem, wp.Other)
+	case ProblemInvalidChars:
+		return fmt.Sprintf("%q: %s (%q)", wp.Word, wp.Problem, string(wp.Chars))
+	default:
+		return fmt.Sprintf("%q: %s", wp.Word, wp.Problem)
+	}
+}
+
+// ValidationError is the error CreatePuzzle returns when WithValidation is used and the words list has problems.
+// It wraps ErrInvalidWords, so it can be checked with errors.Is.
+type ValidationError struct {
+	Problems []WordProblem
+}
+
+func (e *ValidationError) Error() string {
+	problems := make([]string, len(e.Problems))
+	for i, wp := range e.Problems {
+		problems[i] = wp.String()
+	}
+	return fmt.Sprintf("%s: %s", ErrInvalidWords, strings.Join(problems, "; "))
+}
+
+func (e *ValidationError) Unwrap() error {
+	return ErrInvalidWords
+}
+
+// The WithValidation option makes CreatePuzzle check the words list with ValidateWords before doing anything else.
+// If there are any problems, the grid is left alone, every word is returned as unplaced, and the error
+// (also reported by Err) is a *ValidationError.
+func WithValidation() Option {
+	return func(ws *WordSearch) {
+		ws.validate = true
+	}
+}
+
+// ValidateWords checks a words list for problems that would keep CreatePuzzle from making a good puzzle,
+// using the puzzle's size, directions and alphabet. Words are normalized first (see NormalizeWord).
+// It returns every problem it finds, in the order of the words list, or nil if there aren't any.
+// A word can have more than one problem.
+func (ws *WordSearch) ValidateWords(words []string) []WordProblem {
+	var problems []WordProblem
+	entries := make([]entry, len(words))
+	for i, word := range words {
+		entries[i] = ws.newEntry(word)
+	}
+	bothWays := ws.readsBothWays()
+	for i, e := range entries {
+		if len(e.word) == 0 {
+			problems = append(problems, WordProblem{Word: e.display, Problem: ProblemEmpty})
+			continue
+		}
+		if !ws.fits(len(e.word)) {
+			problems = append(problems, WordProblem{Word: e.display, Problem: ProblemTooLong})
+		}
+		var invalid []rune
+		for _, r := range e.word {
+			if !slices.Contains(ws.alphabet, r) && !slices.Contains(invalid, r) {
+				invalid = append(invalid, r)
+			}
+		}
+		if invalid != nil {
+			problems = append(problems, WordProblem{Word: e.display, Problem: ProblemInvalidChars, Chars: invalid})
+		}
+		word := string(e.word)
+		reversed := reverse(e.word)
+		duplicate := false
+		for _, other := range entries[:i] {
+			if string(other.word) == word {
+				problems = append(problems, WordProblem{Word: e.display, Problem: ProblemDuplicate, Other: other.display})
+				duplicate = true
+				break
+			}
+		}
+		if !duplicate {
+			for j, other := range entries {
+				if j == i || string(other.word) == word {
+					continue
+				}
+				if strings.Contains(string(other.word), word) || (bothWays && strings.Contains(string(other.word), reversed)) {
+					problems = append(problems, WordProblem{Word: e.display, Problem: ProblemContained, Other: other.display})
+					break
+				}
+			}
+		}
+		if bothWays && len(e.word) > 1 && word == reversed {
+			problems = append(problems, WordProblem{Word: e.display, Problem: ProblemPalindrome})
+		}
+	}
+	return problems
+}
+
+// fits reports whether a word of this length fits in the grid in any of the allowed directions,
+// without any of its letters falling on a blank cell
+func (ws *WordSearch) fits(length int) bool {
+	for _, cardinal := range ws.Directions {
+		if ws.candidateCount(length, cardinal) == 0 {
+			continue
+		}
+		if ws.mask == nil {
+			return true
+		}
+		for _, cand := range ws.candidates(length, []string{cardinal}) {
+			blank := false
+			for _, cell := range cand.cells(length) {
+				if ws.cells[cell[0]][cell[1]].Kind == CellBlank {
+					blank = true
+					break
+				}
+			}
+			if !blank {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// readsBothWays reports whether any of the allowed directions is the opposite of another one,
+// which means a word can be read backwards along the same line
+func (ws *WordSearch) readsBothWays() bool {
+	for _, a := range ws.Directions {
+		va := vector.CardinalToVector(a)
+		for _, b := range ws.Directions {
+			vb := vector.CardinalToVector(b)
+			if va.X == -vb.X && va.Y == -vb.Y {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// reverse returns the runes in reverse order, as a string
+func reverse(word []rune) string {
+	reversed := slices.Clone(word)
+	slices.Reverse(reversed)
+	return string(reversed)
+}
@@ -0,0 +1,142 @@
+package wordsearch
+
+import (
+	"errors"
+	"slices"
+	"testing"
+)
+
+// TestValidateWords checks that each kind of problem is reported, with the word as it was given
+func TestValidateWords(t *testing.T) {
+	tests := []struct {
+		name  string
+		opts  []Option
+		words []string
+		want  []WordProblem
+	}{
+		{
+			name:  "valid list",
+			words: []string{"GOPHER", "CHANNEL", "SLICE"},
+			want:  nil,
+		},
+		{
+			name:  "empty",
+			words: []string{"GOPHER", "", "?!"},
+			want: []WordProblem{
+				{Word: "", Problem: ProblemEmpty},
+				{Word: "?!", Problem: ProblemEmpty},
+			},
+		},
+		{
+			name:  "too long",
+			words: []string{"INTERFACES", "MAP"},
+			want:  []WordProblem{{Word: "INTERFACES", Problem: ProblemTooLong}},
+		},
+		{
+			name:  "too long for a rectangular grid in the allowed directions",
+			opts:  []Option{WithDirections([]string{"S"})},
+			words: []string{"GOPHERS"},
+			want:  []WordProblem{{Word: "GOPHERS", Problem: ProblemTooLong}},
+		},
+		{
+			name:  "too long for the cells a mask leaves",
+			opts:  []Option{WithMaskTemplate("#\n#\n#\n#\n#\n#")},
+			words: []string{"SLICE", "GOPHERS"},
+			want:  []WordProblem{{Word: "GOPHERS", Problem: ProblemTooLong}},
+		},
+		{
+			name:  "long enough to fill the column a mask leaves",
+			opts:  []Option{WithMaskTemplate("#\n#\n#\n#\n#\n#")},
+			words: []string{"CAT", "GOPHER"},
+			want:  nil,
+		},
+		{
+			name:  "invalid characters",
+			words: []string{"R2-D2", "C3PO"},
+			want: []WordProblem{
+				{Word: "R2-D2", Problem: ProblemInvalidChars, Chars: []rune("2")},
+				{Word: "C3PO", Problem: ProblemInvalidChars, Chars: []rune("3")},
+			},
+		},
+		{
+			name:  "duplicate after normalizing",
+			words: []string{"ice cream", "ICE-CREAM"},
+			want:  []WordProblem{{Word: "ICE-CREAM", Problem: ProblemDuplicate, Other: "ice cream"}},
+		},
+		{
+			name:  "contained in another word",
+			words: []string{"CAT", "CATNIP"},
+			want:  []WordProblem{{Word: "CAT", Problem: ProblemContained, Other: "CATNIP"}},
+		},
+		{
+			name:  "contained backwards",
+			words: []string{"PIN", "CATNIP"},
+			want:  []WordProblem{{Word: "PIN", Problem: ProblemContained, Other: "CATNIP"}},
+		},
+		{
+			name:  "contained backwards is fine when words only read forwards",
+			opts:  []Option{WithDirections([]string{"E", "S"})},
+			words: []string{"PIN", "CATNIP"},
+			want:  nil,
+		},
+		{
+			name:  "palindrome",
+			words: []string{"LEVEL", "A"},
+			want:  []WordProblem{{Word: "LEVEL", Problem: ProblemPalindrome}},
+		},
+		{
+			name:  "palindrome is fine when words only read forwards",
+			opts:  []Option{WithDirections([]string{"E", "SE"})},
+			words: []string{"LEVEL"},
+			want:  nil,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := newRectangularWordSearch(t, 8, 6, tt.opts...)
+			got := ws.ValidateWords(tt.words)
+			if len(got) != len(tt.want) {
+				t.Fatalf("expected %d problems, got %d: %v", len(tt.want), len(got), got)
+			}
+			for i := range tt.want {
+				if got[i].Word != tt.want[i].Word || got[i].Problem != tt.want[i].Problem ||
+					got[i].Other != tt.want[i].Other || !slices.Equal(got[i].Chars, tt.want[i].Chars) {
+					t.Errorf("problem %d: expected %v, got %v", i, tt.want[i], got[i])
+				}
+			}
+		})
+	}
+}
+
+// TestWithValidation checks that CreatePuzzle refuses an invalid list and leaves the grid alone
+func TestWithValidation(t *testing.T) {
+	ws := newWordSearch(t, 10, WithValidation(), WithSeed(1))
+	before := ws.ReturnGrid(GridAllUppercase)
+
+	words := []string{"GOPHER", "GO", "SLICE"}
+	unplaced, err := ws.CreatePuzzleContext(t.Context(), words)
+	if !errors.Is(err, ErrInvalidWords) {
+		t.Fatalf("expected ErrInvalidWords, got %v", err)
+	}
+	var verr *ValidationError
+	if !errors.As(ws.Err(), &verr) || len(verr.Problems) != 1 || verr.Problems[0].Problem != ProblemContained {
+		t.Errorf("expected a *ValidationError with one problem, got %v", ws.Err())
+	}
+	if !slices.Equal(unplaced, words) {
+		t.Errorf("expected every word to be unplaced, got %v", unplaced)
+	}
+	if len(ws.Placements()) != 0 {
+		t.Errorf("expected no placements, got %v", ws.Placements())
+	}
+	after := ws.ReturnGrid(GridAllUppercase)
+	for i := range before {
+		if string(before[i]) != string(after[i]) {
+			t.Fatalf("expected the grid to be unchanged")
+		}
+	}
+
+	unplaced, err = ws.CreatePuzzleContext(t.Context(), []string{"GOPHER", "SLICE"})
+	if err != nil || len(unplaced) > 0 {
+		t.Errorf("expected a valid list to be placed, got %v, %v", unplaced, err)
+	}
+}
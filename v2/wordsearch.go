@@ -3,25 +3,31 @@
 // It generates a grid of letters containing a list of hidden words.
 // Configuration includes an optional list of cardinal directions (e.g. "N", "SW", etc.)
 // in which words can be placed, the size of the grid, and whether
-// overlapping letters are allowed. The grid is a 2D slice of bytes
+// overlapping letters are allowed. The grid is a 2D slice of runes
 // containing lowercase letters for "filler" letters and
 // uppercase letters for words that have been explicitly placed.
+// Any alphabet can be used, not just A-Z. The Cell method says whether each cell
+// holds filler or a placed letter, which also works for alphabets without case.
 // A helper function can return the grid in other formats.
 package wordsearch
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"math/rand"
-	"sort"
-	"strings"
+	"slices"
+	"time"
+	"unicode/utf8"
 
 	"github.com/rahji/wordsearch/v2/internal/letters"
 	"github.com/rahji/wordsearch/v2/internal/vector"
 )
 
 const (
-	alphabet = "ABCDEFGHIJKLMNOPQRSTUVWXYZ"
-	attempts = 100 // max number of times to attempt to place a word
+	alphabet     = "ABCDEFGHIJKLMNOPQRSTUVWXYZ" // the default alphabet for filler letters
+	attempts     = 100                          // max number of times to attempt to place a word
+	sizeAttempts = 5                            // max number of puzzles to try at each size when finding the smallest grid for a list of words
 )
 
 // Gridstyle is an enum-like list of ways that the output of ReturnGrid can be styled
@@ -38,12 +44,57 @@ const (
 // WordSearch is a struct that contains the puzzle configuration and the actual grid of rows and cols,
 // which can be accessed directly or via the helper method ReturnGrid. The config includes the
 // size of the puzzle, allowable directions (as one- or two-letter abbreviations for the cardinal directions),
-// and whether overlapping is allowed
+// and whether overlapping is allowed.
+// Size is only set for a square grid. It is 0 for a rectangular grid, so use Width and Height instead.
 type WordSearch struct {
 	Size       int
-	Grid       [][]byte
+	Width      int
+	Height     int
+	Grid       [][]rune
 	Directions []string
 	Overlaps   bool
+	placements []Placement
+	cells      [][]Cell
+	rng        *rand.Rand
+	err        error
+	unique     bool
+	blocklist  []string
+	mask       [][]bool
+	alphabet   []rune
+	// frequencies is the table filler letters are picked from, or nil to pick from the alphabet equally
+	frequencies *fillerTable
+	camouflage  bool
+	decoys      int
+	// backwardRatio is the share of words that should read backwards, or less than zero for no preference
+	backwardRatio float64
+	// backtracking is the search budget, or nil if CreatePuzzle places words greedily
+	backtracking *backtracking
+	// overlapPreference is how strongly positions that share letters with placed words are favored
+	overlapPreference float64
+	validate          bool
+	// directionWeights is the target share of words in each direction, empty for an even spread, or nil for no target
+	directionWeights map[string]float64
+	// attempts counts the positions tried by place, for Result
+	attempts int
+}
+
+// Placement records where a word was written to the grid: the word itself (as it appears in the grid),
+// the row and column of its first letter, the cardinal direction it runs in,
+// and the row and column of its last letter. Display is the word the way it was given,
+// before it was normalized for the grid, which is how it should appear in a word bank.
+type Placement struct {
+	Word     string
+	Display  string
+	Row      int
+	Col      int
+	Cardinal string
+	EndRow   int
+	EndCol   int
+}
+
+// cells returns the row and column of each letter in a placement, from first to last
+func (p Placement) cells() [][2]int {
+	return candidate{row: p.Row, col: p.Col, cardinal: p.Cardinal}.cells(utf8.RuneCountInString(p.Word))
 }
 
 type Option func(*WordSearch)
@@ -51,6 +102,8 @@ type Option func(*WordSearch)
 // The WithDirections option is a slice of strings that are
 // abbreviations for the cardinal directions (N, NE, E, SE, S, SW, W, NW).
 // Those are the word directions that are allowed when generating the puzzle.
+// They are case-insensitive and can also be long names like "north-east". A direction given more than once is only kept once.
+// NewWordSearch returns ErrUnknownDirection if one isn't recognized.
 // If this option is not used, then all directions are allowed.
 func WithDirections(cardinals []string) Option {
 	return func(ws *WordSearch) {
@@ -65,15 +118,30 @@ func WithoutOverlaps() Option {
 	}
 }
 
-// createEmptyGrid creates a 2d slice of bytes with random lowercase letters in each element.
-// Lowercase letters represent letters that were not placed intentionally.
-func createEmptyGrid(size int) [][]byte {
-	arr := make([][]byte, size)
+// The WithSeed option makes puzzle generation deterministic. The same seed, size, options
+// and word list always produce the same grid.
+func WithSeed(seed int64) Option {
+	return func(ws *WordSearch) {
+		// a new source for every puzzle, so the option can be reused
+		ws.rng = rand.New(rand.NewSource(seed))
+	}
+}
+
+// The WithRandSource option supplies the source of randomness used to fill and generate the puzzle.
+// This is useful for reproducing a puzzle, or for sharing a source between several puzzles.
+func WithRandSource(src rand.Source) Option {
+	return func(ws *WordSearch) {
+		ws.rng = rand.New(src)
+	}
+}
+
+// createEmptyGrid creates a 2d slice of runes, calling filler for the letter in each element
+func createEmptyGrid(width int, height int, filler func() rune) [][]rune {
+	arr := make([][]rune, height)
 	for i := range arr {
-		arr[i] = make([]byte, size)
+		arr[i] = make([]rune, width)
 		for j := range arr[i] {
-			randomIndex := rand.Intn(len(alphabet))
-			arr[i][j] = letters.ToLowercase(alphabet[randomIndex])
+			arr[i][j] = filler()
 		}
 	}
 	return arr
@@ -81,36 +149,156 @@ func createEmptyGrid(size int) [][]byte {
 
 // NewWordSearch initializes and returns a WordSearch instance.
 // The size parameter is both the width and height of the (square) grid.
-func NewWordSearch(size int, opt ...Option) *WordSearch {
+// It returns an error if the size isn't positive or one of the options is invalid.
+func NewWordSearch(size int, opt ...Option) (*WordSearch, error) {
+	return NewRectangularWordSearch(size, size, opt...)
+}
+
+// NewRectangularWordSearch initializes and returns a WordSearch instance with a grid that is
+// width columns wide and height rows tall.
+// It returns an error if either dimension isn't positive or one of the options is invalid.
+func NewRectangularWordSearch(width int, height int, opt ...Option) (*WordSearch, error) {
+	if width < 1 || height < 1 {
+		return nil, fmt.Errorf("%w: got %dx%d", ErrInvalidSize, width, height)
+	}
+
 	ws := new(WordSearch)
-	ws.Size = size
-	ws.Grid = createEmptyGrid(size)
-	ws.Overlaps = true // unless it's about to be overwritten by the WithoutOverlaps option
+	ws.Width = width
+	ws.Height = height
+	if width == height {
+		ws.Size = width
+	}
+	ws.Overlaps = true    // unless it's about to be overwritten by the WithoutOverlaps option
+	ws.backwardRatio = -1 // no preference unless it's about to be set by the WithBackwardRatio option
 
 	for _, o := range opt {
 		o(ws)
 	}
 
+	if ws.rng == nil {
+		ws.rng = rand.New(rand.NewSource(time.Now().UnixNano()))
+	}
+	if ws.alphabet == nil {
+		ws.alphabet = []rune(alphabet)
+	}
+	if len(ws.alphabet) == 0 {
+		return nil, ErrEmptyAlphabet
+	}
+	// a frequency table (e.g. English, from the Medium difficulty) can't add letters that aren't in the alphabet
+	ws.frequencies = ws.frequencies.within(ws.alphabet)
+	blocklist := ws.blocklist
+	ws.blocklist = nil
+	for _, word := range blocklist {
+		if normalized := ws.NormalizeWord(word); normalized != "" {
+			ws.blocklist = append(ws.blocklist, normalized)
+		}
+	}
+	ws.Grid = createEmptyGrid(width, height, ws.randomFiller)
+	ws.cells = createCells(width, height)
+	ws.applyMask()
+
 	if ws.Directions == nil {
-		ws.Directions = []string{"N", "NE", "E", "SE", "S", "SW", "W", "NW"}
+		ws.Directions = append([]string(nil), vector.Cardinals...)
+	}
+	if len(ws.Directions) == 0 {
+		return nil, ErrNoDirections
+	}
+	directions := make([]string, 0, len(ws.Directions))
+	for _, d := range ws.Directions {
+		cardinal, ok := vector.ParseCardinal(d)
+		if !ok {
+			return nil, fmt.Errorf("%w: %q", ErrUnknownDirection, d)
+		}
+		// a direction given twice (like "E" and "east") would be picked twice as often
+		if !slices.Contains(directions, cardinal) {
+			directions = append(directions, cardinal)
+		}
+	}
+	ws.Directions = directions
+	if ws.directionWeights != nil {
+		if err := ws.parseDirectionWeights(); err != nil {
+			return nil, err
+		}
+	}
+
+	return ws, nil
+}
+
+// NewWordSearchForWords finds the smallest square grid in which every word can be placed,
+// and returns a WordSearch with the puzzle already created. The grid is at least minSize wide
+// and at least as wide as the longest word. Several puzzles are tried at each size before moving
+// on to the next one. The words slice isn't changed. It returns ErrWordsDontFit if even a grid
+// wide enough to give each word its own line (which can happen with a mask or a blocklist) doesn't work.
+// It returns an error that wraps ErrEmptyWord straight away if a word has nothing to place once it's normalized,
+// and a *ValidationError if WithValidation is used and the words list has problems.
+func NewWordSearchForWords(words []string, minSize int, opt ...Option) (*WordSearch, error) {
+	// a throwaway puzzle checks the options and normalizes the words with the right alphabet
+	probe, err := NewWordSearch(1, opt...)
+	if err != nil {
+		return nil, err
+	}
+	// no grid size can fix an invalid list or a word with nothing to place, except for a word that is too long
+	// for the 1x1 probe, since the search makes the grid big enough
+	if probe.validate {
+		var problems []WordProblem
+		for _, wp := range probe.ValidateWords(words) {
+			if wp.Problem != ProblemTooLong {
+				problems = append(problems, wp)
+			}
+		}
+		if problems != nil {
+			return nil, &ValidationError{Problems: problems}
+		}
+	}
+	longest := 0
+	for _, word := range words {
+		length := len(probe.normalize(word))
+		if length == 0 {
+			return nil, fmt.Errorf("%w: %q", ErrEmptyWord, word)
+		}
+		longest = max(longest, length)
 	}
+	size := max(minSize, longest, 1)
+	maxSize := max(size, longest+len(words))
 
-	return ws
+	// every try after the first gets its own seed, drawn from the first try's source,
+	// so the tries differ from each other but the search is still deterministic with WithSeed
+	var seeds *rand.Rand
+	for ; size <= maxSize; size++ {
+		for range sizeAttempts {
+			tryOpt := opt
+			if seeds != nil {
+				tryOpt = append(slices.Clip(opt), WithSeed(seeds.Int63()))
+			}
+			ws, err := NewWordSearch(size, tryOpt...)
+			if err != nil {
+				return nil, err
+			}
+			unplaced := ws.CreatePuzzle(words)
+			if len(unplaced) == 0 && ws.Err() == nil {
+				return ws, nil
+			}
+			if seeds == nil {
+				seeds = ws.rng
+			}
+		}
+	}
+	return nil, fmt.Errorf("%w: tried up to %dx%d", ErrWordsDontFit, maxSize, maxSize)
 }
 
-// ReturnGrid returns the grid, with the bytes restyled using a parameter of the GridStyle type
-func (ws *WordSearch) ReturnGrid(style GridStyle) [][]byte {
+// ReturnGrid returns the grid, with the runes restyled using a parameter of the GridStyle type
+func (ws *WordSearch) ReturnGrid(style GridStyle) [][]rune {
 	if style == GridRaw {
 		return ws.Grid
 	}
 
-	returnGrid := make([][]byte, len(ws.Grid))
+	returnGrid := make([][]rune, len(ws.Grid))
 	for r := range ws.Grid {
-		returnGrid[r] = make([]byte, len(ws.Grid[r]))
+		returnGrid[r] = make([]rune, len(ws.Grid[r]))
 	}
 
-	// if the lowercase letters are going to be replaced with symbols...
-	replacementChar := byte(0)
+	// if the filler letters are going to be replaced with symbols...
+	replacementChar := rune(0)
 	switch style {
 	case GridWithDots:
 		replacementChar = '.'
@@ -118,12 +306,12 @@ func (ws *WordSearch) ReturnGrid(style GridStyle) [][]byte {
 		replacementChar = ' '
 	}
 
-	// loop through the grid and replace either lowercase or uppercase letters...
+	// loop through the grid and replace either filler letters or the case of every letter...
 	for i, row := range ws.Grid {
 		for j, b := range row {
 			returnGrid[i][j] = b
-			// replace lowercase byte with a symbol if that's the style
-			if replacementChar != 0 && letters.IsLowercase(b) {
+			// replace a filler letter with a symbol if that's the style
+			if replacementChar != 0 && ws.cells[i][j].Kind == CellFiller {
 				returnGrid[i][j] = replacementChar
 			}
 			if style == GridAllLowercase {
@@ -137,75 +325,343 @@ func (ws *WordSearch) ReturnGrid(style GridStyle) [][]byte {
 	return returnGrid
 }
 
+// These are the reasons NewWordSearch can fail
+var (
+	ErrInvalidSize      = errors.New("the grid must be at least 1x1")
+	ErrUnknownDirection = errors.New("unrecognized cardinal direction")
+	ErrNoDirections     = errors.New("at least one direction is required")
+	ErrEmptyAlphabet    = errors.New("the alphabet must have at least one letter")
+)
+
+// ErrWordsDontFit means NewWordSearchForWords couldn't find a grid that fits every word
+var ErrWordsDontFit = errors.New("the words don't fit in any grid size that was tried")
+
+// These are the reasons PlaceWord can fail. They are wrapped in a *PlacementError,
+// so use errors.Is to check for one of them or errors.As to get the details.
+var (
+	ErrOutOfBounds       = errors.New("word extends outside of the grid")
+	ErrBlankCell         = errors.New("a letter would fall on a blank cell")
+	ErrOverlapDisallowed = errors.New("a letter would overlap another letter and overlaps are disallowed")
+	ErrLetterConflict    = errors.New("a letter would overwrite an existing (different) letter")
+	ErrInsideWord        = errors.New("word would be completely inside another word")
+	ErrEmptyWord         = errors.New("the word is empty")
+)
+
+// PlacementError explains why PlaceWord couldn't place a word. Row and Col are the cell where
+// the placement failed, and Letter is whatever was already in that cell (or 0 if the cell is outside the grid).
+// Reason is one of the Err variables above, or ErrUnknownDirection.
+type PlacementError struct {
+	Word     string
+	Row      int
+	Col      int
+	Cardinal string
+	Letter   rune
+	Reason   error
+}
+
+func (e *PlacementError) Error() string {
+	if e.Letter == 0 {
+		return fmt.Sprintf("can't place %s at row %d, col %d: %v", e.Word, e.Row, e.Col, e.Reason)
+	}
+	return fmt.Sprintf("can't place %s at row %d, col %d (%q): %v", e.Word, e.Row, e.Col, e.Letter, e.Reason)
+}
+
+func (e *PlacementError) Unwrap() error {
+	return e.Reason
+}
+
+// placementError builds a *PlacementError for a placement that failed at a specific cell
+func (ws *WordSearch) placementError(word []rune, row int, col int, cardinal string, reason error) error {
+	e := &PlacementError{Word: string(word), Row: row, Col: col, Cardinal: cardinal, Reason: reason}
+	if row >= 0 && row < ws.Height && col >= 0 && col < ws.Width {
+		e.Letter = ws.Grid[row][col]
+	}
+	return e
+}
+
 // PlaceWord tries to write a single word to a specific place on the grid in a specific direction.
-// This function is where the word gets capitalized. It is assumed to be a word made only of the letters A-Z.
-// It returns an error if it can't be done for some reason. The possible reasons for failure are:
-//  1. The placement would extend outside of the grid
-//  2. A letter in the word would overwrite an existing (different) letter
-//  3. A letter overlaps another placed letter and overlaps are disallowed in this word search
-//  4. Overlaps are alllowed, but the word would be placed completely inside another word (which is never allowed)
+// This function is where the word gets capitalized, one letter at a time (so ß becomes ẞ rather than SS).
+// It is assumed to be a word made only of letters.
+// It returns a *PlacementError if it can't be done for some reason. The possible reasons for failure are:
+//  1. The placement would extend outside of the grid (ErrOutOfBounds)
+//  2. A letter in the word would overwrite an existing (different) letter (ErrLetterConflict)
+//  3. A letter overlaps another placed letter and overlaps are disallowed in this word search (ErrOverlapDisallowed)
+//  4. Overlaps are alllowed, but the word would be placed completely inside another word (which is never allowed) (ErrInsideWord)
+//  5. A letter would fall on a blank cell that a mask has left out of the puzzle (ErrBlankCell)
+//  6. The word is empty (ErrEmptyWord)
+//
+// The cardinal direction can be anything accepted by WithDirections. If it isn't recognized,
+// the reason is ErrUnknownDirection.
 func (ws *WordSearch) PlaceWord(word string, row int, col int, cardinal string) error {
-	dir := vector.CardinalToVector(cardinal)
-	overlapCount := 0 // the number of valid overlapping letters (a complete overlap of words is invalid)
+	e := entry{word: letters.Upper(word), display: word}
+	parsed, ok := vector.ParseCardinal(cardinal)
+	if !ok {
+		return &PlacementError{Word: string(e.word), Row: row, Col: col, Cardinal: cardinal, Reason: ErrUnknownDirection}
+	}
+	return ws.place(e, candidate{row: row, col: col, cardinal: parsed})
+}
 
-	// make a deep copy of the grid so a failed attempt won't screw up the real grid
-	tempGrid := make([][]byte, len(ws.Grid))
-	for i := range ws.Grid {
-		tempGrid[i] = make([]byte, len(ws.Grid[i]))
-		copy(tempGrid[i], ws.Grid[i])
+// place writes an entry to the grid at a candidate position, or returns a *PlacementError if it doesn't fit
+func (ws *WordSearch) place(e entry, cand candidate) error {
+	ws.attempts++
+	if len(e.word) == 0 {
+		return &PlacementError{Row: cand.row, Col: cand.col, Cardinal: cand.cardinal, Reason: ErrEmptyWord}
+	}
+	// check the whole word first, so a failed attempt doesn't need to undo anything
+	if _, err := ws.checkPlacement(e.word, cand); err != nil {
+		return err
 	}
-	// loop through each byte of the word
-	word = strings.ToUpper(word)
+	cells := cand.cells(len(e.word))
+	for i, cell := range cells {
+		ws.Grid[cell[0]][cell[1]] = e.word[i]
+		ws.cover(cell[0], cell[1], len(ws.placements))
+	}
+	last := cells[len(cells)-1]
+	ws.placements = append(ws.placements, Placement{
+		Word:     string(e.word),
+		Display:  e.display,
+		Row:      cand.row,
+		Col:      cand.col,
+		Cardinal: cand.cardinal,
+		EndRow:   last[0],
+		EndCol:   last[1],
+	})
+	return nil
+}
+
+// checkPlacement makes sure an uppercase word could be written to the grid at a candidate position,
+// without changing anything. It returns the number of letters that would land on the same letter of
+// an already placed word, or a *PlacementError explaining why the word can't go there.
+func (ws *WordSearch) checkPlacement(word []rune, cand candidate) (overlapCount int, err error) {
+	dir := vector.CardinalToVector(cand.cardinal)
+	// loop through each rune of the word
 	for i := 0; i < len(word); i++ {
-		r := row + i*dir.Y
-		c := col + i*dir.X
-		if r < 0 || r >= ws.Size || c < 0 || c >= ws.Size {
-			return errors.New("word extends outside of the grid")
+		r := cand.row + i*dir.Y
+		c := cand.col + i*dir.X
+		if r < 0 || r >= ws.Height || c < 0 || c >= ws.Width {
+			return 0, ws.placementError(word, r, c, cand.cardinal, ErrOutOfBounds)
+		}
+		kind := ws.cells[r][c].Kind
+		if kind == CellBlank {
+			return 0, ws.placementError(word, r, c, cand.cardinal, ErrBlankCell)
 		}
-		if letters.IsUppercase(ws.Grid[r][c]) && ws.Overlaps == false {
-			return errors.New("a letter would overlap another letter and overlaps are disallowed")
+		if kind == CellPlaced && ws.Overlaps == false {
+			return 0, ws.placementError(word, r, c, cand.cardinal, ErrOverlapDisallowed)
 		}
-		if letters.IsUppercase(ws.Grid[r][c]) && ws.Grid[r][c] != word[i] {
-			return errors.New("a letter would overwrite an existing (different) letter")
+		if kind == CellPlaced && ws.Grid[r][c] != word[i] {
+			return 0, ws.placementError(word, r, c, cand.cardinal, ErrLetterConflict)
 		}
-		if ws.Grid[r][c] == word[i] {
+		if kind == CellPlaced {
 			overlapCount++
 		}
 		if overlapCount == len(word) {
-			return errors.New("word would be completely inside another word")
+			return 0, ws.placementError(word, r, c, cand.cardinal, ErrInsideWord)
 		}
-		tempGrid[r][c] = word[i]
 	}
-	ws.Grid = tempGrid
-	return nil
+	return overlapCount, nil
+}
+
+// Placements returns a record of every word that has been placed on the grid, in the order they were placed.
+// The returned slice is a copy, so changing it has no effect on the WordSearch.
+func (ws *WordSearch) Placements() []Placement {
+	placements := make([]Placement, len(ws.placements))
+	copy(placements, ws.placements)
+	return placements
 }
 
-// CreatePuzzle places words from a words list, after sorting them by length, longest first.
-// It returns nil if successful. Otherwise it returns a slice of words that could not be placed
-// after the maximum number of attempts.
+// CreatePuzzle places words from a words list, longest first. The words slice isn't changed.
+// Each word is normalized first (see NormalizeWord), and a word that normalizes to nothing is unplaced.
+// Words are always returned the way they were given, as is the Display field of each Placement.
+// Each word gets a number of random attempts, and if those all fail then every possible position
+// and direction is tried. It returns nil if successful. Otherwise it returns a slice of words that
+// could not be placed anywhere. Any other problem with the finished grid is reported by Err.
 func (ws *WordSearch) CreatePuzzle(words []string) (unplaced []string) {
-	// sort the slice of words by length, longest first
-	// this is to avoid a shorter word being placed entirely within another longer word
-	sort.Slice(words, func(i, j int) bool {
-		return len(words[i]) > len(words[j])
-	})
-	// make a bunch of random attempts to fit each word into the grid
-	for _, word := range words {
-		placed := false
-		for range attempts {
-			randomIndex := rand.Intn(len(ws.Directions))
-			randomCardinal := ws.Directions[randomIndex]
-			row := rand.Intn(ws.Size - 1)
-			col := rand.Intn(ws.Size - 1)
-			err := ws.PlaceWord(word, row, col, randomCardinal)
-			if err == nil {
-				placed = true
-				break
+	unplaced, _ = ws.CreatePuzzleContext(context.Background(), words)
+	return unplaced
+}
+
+// CreatePuzzleContext is like CreatePuzzle, but it stops early if the context is cancelled or times out.
+// The context is checked between attempts to place a word. If it's done, the words placed so far stay
+// in the grid, every word that wasn't placed yet is returned as unplaced, and the error is ctx.Err().
+// Otherwise the error is the same one that Err reports, which includes a *ValidationError if WithValidation is used.
+func (ws *WordSearch) CreatePuzzleContext(ctx context.Context, words []string) (unplaced []string, err error) {
+	result, err := ws.Generate(ctx, words)
+	for _, u := range result.Unplaced {
+		unplaced = append(unplaced, u.Word)
+	}
+	return unplaced, err
+}
+
+// placeEach places the entries one at a time. If the context is done, it stops and the rest of the entries are unplaced.
+func (ws *WordSearch) placeEach(ctx context.Context, entries []entry) (unplaced []Unplaced) {
+	for i, e := range entries {
+		if !ws.placeWord(ctx, e) {
+			if ctx.Err() != nil {
+				for _, rest := range entries[i:] {
+					unplaced = append(unplaced, Unplaced{Word: rest.display, Reason: ctx.Err()})
+				}
+				return unplaced
 			}
+			unplaced = append(unplaced, Unplaced{Word: e.display, Reason: ErrNoRoom})
+		}
+	}
+	return unplaced
+}
+
+// placeWord places a single word, trying each group of preferred directions in turn if there are any.
+// It returns false if the word couldn't be placed or the context is done.
+func (ws *WordSearch) placeWord(ctx context.Context, e entry) bool {
+	for _, preferred := range ws.preferredDirections() {
+		if ws.placeRandomly(ctx, e, preferred) {
+			return true
+		}
+		if ctx.Err() != nil {
+			return false
+		}
+	}
+	return ws.placeRandomly(ctx, e, ws.Directions)
+}
+
+// placeRandomly makes a bunch of random attempts to fit a word into the grid in one of the directions,
+// then falls back to trying everywhere. It returns false if the word couldn't be placed
+// or the context is done.
+func (ws *WordSearch) placeRandomly(ctx context.Context, e entry, directions []string) bool {
+	if ws.overlapPreference > 0 {
+		// random attempts would ignore the preference, so go straight to ranking every position
+		return ws.placeAnywhere(ctx, e, directions)
+	}
+	for range attempts {
+		if ctx.Err() != nil {
+			return false
 		}
-		if placed == false {
-			unplaced = append(unplaced, word)
+		cand, ok := ws.randomCandidate(len(e.word), directions)
+		if !ok {
+			return false
+		}
+		if ws.place(e, cand) == nil {
+			return true
 		}
 	}
-	return
+	return ws.placeAnywhere(ctx, e, directions)
+}
+
+// candidate is a start cell and direction that a word could be placed in
+type candidate struct {
+	row      int
+	col      int
+	cardinal string
+}
+
+// cells returns the row and column of each letter of a word of the given length, from first to last
+func (cand candidate) cells(length int) [][2]int {
+	dir := vector.CardinalToVector(cand.cardinal)
+	cells := make([][2]int, length)
+	for i := range cells {
+		cells[i] = [2]int{cand.row + i*dir.Y, cand.col + i*dir.X}
+	}
+	return cells
+}
+
+// starts returns the first start row (or column) for a word of this length running along a grid
+// dimension of this size with a step of delta (-1, 0 or 1), and how many starts there are.
+// The count is zero or less if the word is too long.
+func starts(length int, delta int, size int) (first int, count int) {
+	switch {
+	case delta == 0:
+		return 0, size
+	case delta > 0:
+		return 0, size - (length - 1)
+	default:
+		return length - 1, size - (length - 1)
+	}
+}
+
+// candidates returns every start cell and direction (out of the ones given) where a word of this length
+// would fit inside the grid. It doesn't check whether the word clashes with anything already there.
+func (ws *WordSearch) candidates(length int, directions []string) []candidate {
+	var cands []candidate
+	for _, cardinal := range directions {
+		dir := vector.CardinalToVector(cardinal)
+		firstRow, rows := starts(length, dir.Y, ws.Height)
+		firstCol, cols := starts(length, dir.X, ws.Width)
+		for row := firstRow; row < firstRow+rows; row++ {
+			for col := firstCol; col < firstCol+cols; col++ {
+				cands = append(cands, candidate{row: row, col: col, cardinal: cardinal})
+			}
+		}
+	}
+	return cands
+}
+
+// randomCandidate picks one of the candidates for a word of this length at random, without listing them all.
+// Every start cell and direction where the word fits inside the grid is equally likely.
+// It returns false if the word doesn't fit anywhere.
+func (ws *WordSearch) randomCandidate(length int, directions []string) (candidate, bool) {
+	total := 0
+	for _, cardinal := range directions {
+		total += ws.candidateCount(length, cardinal)
+	}
+	if total == 0 {
+		return candidate{}, false
+	}
+	n := ws.rng.Intn(total)
+	for _, cardinal := range directions {
+		count := ws.candidateCount(length, cardinal)
+		if n >= count {
+			n -= count
+			continue
+		}
+		dir := vector.CardinalToVector(cardinal)
+		firstRow, _ := starts(length, dir.Y, ws.Height)
+		firstCol, cols := starts(length, dir.X, ws.Width)
+		return candidate{row: firstRow + n/cols, col: firstCol + n%cols, cardinal: cardinal}, true
+	}
+	panic("unreachable")
+}
+
+// candidateCount returns the number of start cells where a word of this length fits inside the grid in one direction
+func (ws *WordSearch) candidateCount(length int, cardinal string) int {
+	dir := vector.CardinalToVector(cardinal)
+	_, rows := starts(length, dir.Y, ws.Height)
+	_, cols := starts(length, dir.X, ws.Width)
+	if rows <= 0 || cols <= 0 {
+		return 0
+	}
+	return rows * cols
+}
+
+// shuffledCandidates returns every position and direction (out of the ones given) where a word would fit
+// inside the grid, in random order. If there is an overlap preference, positions that share more letters
+// with placed words tend to come first.
+func (ws *WordSearch) shuffledCandidates(word []rune, directions []string) []candidate {
+	cands := ws.candidates(len(word), directions)
+	if ws.overlapPreference > 0 {
+		return ws.rankByOverlap(word, cands)
+	}
+	ws.rng.Shuffle(len(cands), func(i, j int) {
+		cands[i], cands[j] = cands[j], cands[i]
+	})
+	return cands
+}
+
+// placeAnywhere tries every possible position and direction (out of the ones given) for a word,
+// in random order, and places it in the first one that works. It returns false if there is
+// nowhere the word can go or the context is done.
+func (ws *WordSearch) placeAnywhere(ctx context.Context, e entry, directions []string) bool {
+	for _, cand := range ws.shuffledCandidates(e.word, directions) {
+		if ctx.Err() != nil {
+			return false
+		}
+		if ws.place(e, cand) == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Err returns the error, if any, that kept the last call to CreatePuzzle from finishing the grid
+// the way its options asked (e.g. a word that could not be made to appear only once).
+// The words that were placed are still in the grid.
+func (ws *WordSearch) Err() error {
+	return ws.err
 }
@@ -1,20 +1,44 @@
 package wordsearch
 
 import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
 	"testing"
+	"time"
+
+	"github.com/rahji/wordsearch/v2/internal/vector"
 )
 
 // printGrid is a private function that logs the grid
-func printGrid(t *testing.T, grid [][]byte) {
+func printGrid(t *testing.T, grid [][]rune) {
 	for _, cell := range grid {
 		t.Log(string(cell))
 	}
 }
 
+// newWordSearch is a private function that creates a square WordSearch or fails the test
+func newWordSearch(t *testing.T, size int, opt ...Option) *WordSearch {
+	t.Helper()
+	return newRectangularWordSearch(t, size, size, opt...)
+}
+
+// newRectangularWordSearch is a private function that creates a WordSearch or fails the test
+func newRectangularWordSearch(t *testing.T, width int, height int, opt ...Option) *WordSearch {
+	t.Helper()
+	ws, err := NewRectangularWordSearch(width, height, opt...)
+	if err != nil {
+		t.Fatalf("NewRectangularWordSearch() error = %v", err)
+	}
+	return ws
+}
+
 // TestCreateEmptyGrid creates a WordSearch instance and verifies that an empty grid was created
 func TestCreateEmptyGrid(t *testing.T) {
 
-	ws := NewWordSearch(15) // default is all directions, with overlaps
+	ws := newWordSearch(t, 15) // default is all directions, with overlaps
 
 	t.Run("Check if the row slice has the correct length", func(t *testing.T) {
 		if len(ws.Grid) != 15 {
@@ -94,7 +118,7 @@ func TestPlaceWord(t *testing.T) {
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			ws := NewWordSearch(10)
+			ws := newWordSearch(t, 10)
 			err := ws.PlaceWord("FOUR", tt.row, tt.col, tt.direction)
 			if (err != nil) != tt.wantError {
 				t.Errorf("PlaceWord() error = %v, wantError %v got %v", err, tt.wantError, err != nil)
@@ -112,7 +136,7 @@ func TestOverlappingWords(t *testing.T) {
 	// The Overlaps field will be changed directly depending on the test below
 	// We're creating the ws variable here because each test builds on the previous one
 	// so we don't want to recreate ws with each test!
-	ws := NewWordSearch(10)
+	ws := newWordSearch(t, 10)
 
 	tests := []struct {
 		name      string
@@ -195,13 +219,13 @@ func TestCreatePuzzle(t *testing.T) {
 	}{
 		{
 			name:           "normal 8x8 grid: ONE TWO THREE FOUR",
-			wordsearch:     *NewWordSearch(8),
+			wordsearch:     *newWordSearch(t, 8),
 			words:          []string{"ONE", "TWO", "THREE", "FOUR"},
 			expectUnplaced: false,
 		},
 		{
 			name:           "impossible 3x3 grid: ONE OOO TWO DOS PRO",
-			wordsearch:     *NewWordSearch(3),
+			wordsearch:     *newWordSearch(t, 3),
 			words:          []string{"ONE", "OOO", "TWO", "DOS", "PRO"},
 			expectUnplaced: true,
 		},
@@ -218,3 +242,609 @@ func TestCreatePuzzle(t *testing.T) {
 		})
 	}
 }
+
+// TestPlacements checks that every placed word is recorded with its start, direction and end
+func TestPlacements(t *testing.T) {
+	ws := newWordSearch(t, 10)
+	if err := ws.PlaceWord("four", 9, 0, "NE"); err != nil {
+		t.Fatalf("PlaceWord() error = %v", err)
+	}
+	if err := ws.PlaceWord("FIVE", 0, 9, "W"); err != nil {
+		t.Fatalf("PlaceWord() error = %v", err)
+	}
+	// a failed placement should not be recorded
+	if err := ws.PlaceWord("SIX", 0, 0, "N"); err == nil {
+		t.Fatalf("expected PlaceWord() to fail")
+	}
+
+	want := []Placement{
+		{Word: "FOUR", Display: "four", Row: 9, Col: 0, Cardinal: "NE", EndRow: 6, EndCol: 3},
+		{Word: "FIVE", Display: "FIVE", Row: 0, Col: 9, Cardinal: "W", EndRow: 0, EndCol: 6},
+	}
+	got := ws.Placements()
+	if len(got) != len(want) {
+		t.Fatalf("expected %d placements, got %d: %v", len(want), len(got), got)
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("placement %d: expected %+v, got %+v", i, want[i], got[i])
+		}
+	}
+
+	t.Run("CreatePuzzle records every placed word", func(t *testing.T) {
+		ws := newWordSearch(t, 8)
+		words := []string{"ONE", "TWO", "THREE", "FOUR"}
+		unplaced := ws.CreatePuzzle(words)
+		placements := ws.Placements()
+		if len(placements)+len(unplaced) != len(words) {
+			t.Errorf("expected %d placed and unplaced words, got %d + %d", len(words), len(placements), len(unplaced))
+		}
+		for _, p := range placements {
+			if string(ws.Grid[p.EndRow][p.EndCol]) != p.Word[len(p.Word)-1:] {
+				t.Errorf("%s: expected last letter at [%d][%d], got %c", p.Word, p.EndRow, p.EndCol, ws.Grid[p.EndRow][p.EndCol])
+			}
+		}
+	})
+}
+
+// TestWithSeed checks that the same seed, size, options and words always produce the same grid
+func TestWithSeed(t *testing.T) {
+	words := []string{"ONE", "TWO", "THREE", "FOUR", "FIVE", "SIX"}
+	generate := func(seed int64) *WordSearch {
+		ws := newWordSearch(t, 10, WithSeed(seed), WithoutOverlaps())
+		ws.CreatePuzzle(words)
+		return ws
+	}
+
+	a, b := generate(42), generate(42)
+	for i := range a.Grid {
+		if string(a.Grid[i]) != string(b.Grid[i]) {
+			t.Errorf("row %d: expected identical rows, got %s and %s", i, string(a.Grid[i]), string(b.Grid[i]))
+		}
+	}
+
+	c := generate(43)
+	same := true
+	for i := range a.Grid {
+		if string(a.Grid[i]) != string(c.Grid[i]) {
+			same = false
+		}
+	}
+	if same {
+		t.Errorf("expected different seeds to produce different grids")
+	}
+	printGrid(t, a.ReturnGrid(GridWithDots))
+
+	t.Run("the same option value can be reused", func(t *testing.T) {
+		opts := []Option{WithSeed(42)}
+		a, b := newWordSearch(t, 8, opts...), newWordSearch(t, 8, opts...)
+		for i := range a.Grid {
+			if string(a.Grid[i]) != string(b.Grid[i]) {
+				t.Errorf("row %d: expected identical rows, got %s and %s", i, string(a.Grid[i]), string(b.Grid[i]))
+			}
+		}
+	})
+
+	t.Run("the same option value can be used concurrently", func(t *testing.T) {
+		opt := WithSeed(42)
+		var wg sync.WaitGroup
+		for range 4 {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				ws, err := NewWordSearch(10, opt)
+				if err != nil {
+					t.Errorf("NewWordSearch() error = %v", err)
+					return
+				}
+				ws.CreatePuzzle(words)
+			}()
+		}
+		wg.Wait()
+	})
+}
+
+// TestRectangularWordSearch checks that a non-square grid has the right shape and honors its bounds
+func TestRectangularWordSearch(t *testing.T) {
+	ws := newRectangularWordSearch(t, 12, 5)
+	if ws.Width != 12 || ws.Height != 5 || ws.Size != 0 {
+		t.Errorf("expected width 12, height 5, size 0, got %d, %d, %d", ws.Width, ws.Height, ws.Size)
+	}
+	if len(ws.Grid) != 5 {
+		t.Errorf("expected 5 rows, got %d", len(ws.Grid))
+	}
+	for i, row := range ws.Grid {
+		if len(row) != 12 {
+			t.Errorf("row %d: expected length of 12, got %d", i, len(row))
+		}
+	}
+
+	tests := []struct {
+		name      string
+		row, col  int
+		direction string
+		wantError bool
+	}{
+		{name: "long word fits across", row: 0, col: 0, direction: "E", wantError: false},
+		{name: "long word doesn't fit down", row: 0, col: 0, direction: "S", wantError: true},
+		{name: "long word runs off the right edge", row: 4, col: 5, direction: "E", wantError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := newRectangularWordSearch(t, 12, 5)
+			err := ws.PlaceWord("LANDSCAPE", tt.row, tt.col, tt.direction)
+			if (err != nil) != tt.wantError {
+				t.Errorf("PlaceWord() error = %v, wantError %v", err, tt.wantError)
+			}
+		})
+	}
+
+	t.Run("CreatePuzzle fills a wide grid", func(t *testing.T) {
+		ws := newRectangularWordSearch(t, 12, 5, WithSeed(5))
+		unplaced := ws.CreatePuzzle([]string{"LANDSCAPE", "PORTRAIT", "PAGE", "PHONE"})
+		if len(unplaced) > 0 {
+			t.Errorf("expected no unplaced, got %v", unplaced)
+		}
+		grid := ws.ReturnGrid(GridWithDots)
+		if len(grid) != 5 || len(grid[0]) != 12 {
+			t.Errorf("expected a 12x5 grid from ReturnGrid, got %dx%d", len(grid[0]), len(grid))
+		}
+		printGrid(t, grid)
+	})
+}
+
+// TestPlacementErrors checks that each kind of failed placement can be told apart with errors.Is and errors.As
+func TestPlacementErrors(t *testing.T) {
+	mask := [][]bool{
+		{true, true, true, true, true},
+		{true, true, true, true, true},
+		{true, true, true, true, false},
+		{true, true, true, true, true},
+		{true, true, true, true, true},
+	}
+	ws := newWordSearch(t, 5, WithMask(mask))
+	if err := ws.PlaceWord("CAT", 0, 0, "E"); err != nil {
+		t.Fatalf("PlaceWord() error = %v", err)
+	}
+
+	tests := []struct {
+		name       string
+		word       string
+		row, col   int
+		direction  string
+		overlaps   bool
+		wantReason error
+		wantRow    int
+		wantCol    int
+		wantLetter rune
+	}{
+		{"out of bounds", "DOG", 4, 3, "E", true, ErrOutOfBounds, 4, 5, 0},
+		{"blank cell", "DOG", 2, 2, "E", true, ErrBlankCell, 2, 4, ' '},
+		{"overlap disallowed", "TOP", 0, 2, "S", false, ErrOverlapDisallowed, 0, 2, 'T'},
+		{"letter conflict", "DOG", 0, 1, "S", true, ErrLetterConflict, 0, 1, 'A'},
+		{"inside another word", "AT", 0, 1, "E", true, ErrInsideWord, 0, 2, 'T'},
+		{"empty word", "", 1, 1, "E", true, ErrEmptyWord, 1, 1, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws.Overlaps = tt.overlaps
+			err := ws.PlaceWord(tt.word, tt.row, tt.col, tt.direction)
+			if !errors.Is(err, tt.wantReason) {
+				t.Fatalf("expected %v, got %v", tt.wantReason, err)
+			}
+			var pe *PlacementError
+			if !errors.As(err, &pe) {
+				t.Fatalf("expected a *PlacementError, got %T", err)
+			}
+			if pe.Row != tt.wantRow || pe.Col != tt.wantCol || pe.Letter != tt.wantLetter {
+				t.Errorf("expected failure at [%d][%d] (%q), got [%d][%d] (%q)", tt.wantRow, tt.wantCol, tt.wantLetter, pe.Row, pe.Col, pe.Letter)
+			}
+			t.Log(err)
+		})
+	}
+}
+
+// TestDirections checks that directions are validated and normalized when the WordSearch is created
+func TestDirections(t *testing.T) {
+	tests := []struct {
+		name       string
+		directions []string
+		want       []string
+		wantError  error
+	}{
+		{"abbreviations", []string{"N", "SE"}, []string{"N", "SE"}, nil},
+		{"lowercase abbreviations", []string{"ne", "w"}, []string{"NE", "W"}, nil},
+		{"long names", []string{"East", "north-east", "South West", "NORTHWEST"}, []string{"E", "NE", "SW", "NW"}, nil},
+		{"duplicates", []string{"E", "east", "S", "e"}, []string{"E", "S"}, nil},
+		{"typo", []string{"E", "Est"}, nil, ErrUnknownDirection},
+		{"no directions", []string{}, nil, ErrNoDirections},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws, err := NewWordSearch(5, WithDirections(tt.directions))
+			if !errors.Is(err, tt.wantError) {
+				t.Fatalf("expected error %v, got %v", tt.wantError, err)
+			}
+			if err != nil {
+				return
+			}
+			if strings.Join(ws.Directions, ",") != strings.Join(tt.want, ",") {
+				t.Errorf("expected directions %v, got %v", tt.want, ws.Directions)
+			}
+		})
+	}
+
+	t.Run("invalid size", func(t *testing.T) {
+		if _, err := NewRectangularWordSearch(0, 5); !errors.Is(err, ErrInvalidSize) {
+			t.Errorf("expected ErrInvalidSize, got %v", err)
+		}
+	})
+
+	t.Run("PlaceWord doesn't panic on an unknown direction", func(t *testing.T) {
+		ws := newWordSearch(t, 5)
+		if err := ws.PlaceWord("CAT", 0, 0, "sideways"); !errors.Is(err, ErrUnknownDirection) {
+			t.Errorf("expected ErrUnknownDirection, got %v", err)
+		}
+		if err := ws.PlaceWord("CAT", 0, 0, "south"); err != nil {
+			t.Errorf("expected no error, got %v", err)
+		}
+		if p := ws.Placements()[0]; p.Cardinal != "S" {
+			t.Errorf("expected the placement to use the abbreviation S, got %s", p.Cardinal)
+		}
+	})
+}
+
+// TestCreatePuzzleFallback fills a grid so tightly that only an exhaustive search can find the last spots
+func TestCreatePuzzleFallback(t *testing.T) {
+	for seed := range int64(20) {
+		// three 3-letter words fill a 3x3 grid completely when they can only run east without overlapping
+		ws := newWordSearch(t, 3, WithSeed(seed), WithDirections([]string{"E"}), WithoutOverlaps())
+		unplaced := ws.CreatePuzzle([]string{"ONE", "TWO", "SIX"})
+		if len(unplaced) > 0 {
+			t.Errorf("seed %d: expected every word to be placed, got unplaced %v", seed, unplaced)
+			printGrid(t, ws.ReturnGrid(GridWithDots))
+		}
+	}
+
+	t.Run("a word that can't go anywhere is still unplaced", func(t *testing.T) {
+		ws := newWordSearch(t, 3, WithDirections([]string{"E"}), WithoutOverlaps())
+		unplaced := ws.CreatePuzzle([]string{"ONE", "TWO", "SIX", "TEN"})
+		if len(unplaced) != 1 {
+			t.Errorf("expected 1 unplaced word, got %v", unplaced)
+		}
+	})
+}
+
+// TestNewWordSearchForWords checks that the grid grows just enough to fit the words
+func TestNewWordSearchForWords(t *testing.T) {
+	tests := []struct {
+		name    string
+		words   []string
+		minSize int
+		opt     []Option
+		want    int
+	}{
+		{
+			name:    "the longest word sets the size",
+			words:   []string{"ELEPHANT", "CAT", "DOG"},
+			minSize: 3,
+			want:    8,
+		},
+		{
+			name:    "spaces don't count towards the length",
+			words:   []string{"ice cream", "CAT"},
+			minSize: 3,
+			want:    8,
+		},
+		{
+			name:    "the minimum size wins if it's bigger",
+			words:   []string{"CAT", "DOG"},
+			minSize: 10,
+			want:    10,
+		},
+		{
+			name:    "too many words for the longest word's size",
+			words:   []string{"ONE", "TWO", "SIX", "TEN"},
+			minSize: 1,
+			opt:     []Option{WithDirections([]string{"E"}), WithoutOverlaps()},
+			want:    4,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			words := append([]string(nil), tt.words...)
+			ws, err := NewWordSearchForWords(words, tt.minSize, append(tt.opt, WithSeed(1))...)
+			if err != nil {
+				t.Fatalf("NewWordSearchForWords() error = %v", err)
+			}
+			if ws.Size != tt.want {
+				t.Errorf("expected size %d, got %d", tt.want, ws.Size)
+			}
+			if len(ws.Placements()) != len(tt.words) {
+				t.Errorf("expected %d placements, got %d", len(tt.words), len(ws.Placements()))
+			}
+			for i := range words {
+				if words[i] != tt.words[i] {
+					t.Errorf("expected the words slice to be unchanged, got %v", words)
+				}
+			}
+			printGrid(t, ws.ReturnGrid(GridWithDots))
+		})
+	}
+
+	t.Run("words that can never fit", func(t *testing.T) {
+		_, err := NewWordSearchForWords([]string{"CLASS"}, 1, WithBlocklist([]string{"ASS"}))
+		if !errors.Is(err, ErrWordsDontFit) {
+			t.Errorf("expected ErrWordsDontFit, got %v", err)
+		}
+	})
+
+	t.Run("an invalid list fails straight away", func(t *testing.T) {
+		_, err := NewWordSearchForWords([]string{"CAT", "CAT"}, 1, WithValidation())
+		if !errors.Is(err, ErrInvalidWords) {
+			t.Errorf("expected ErrInvalidWords, got %v", err)
+		}
+		if _, err := NewWordSearchForWords([]string{"CAT", "DOG"}, 1, WithValidation()); err != nil {
+			t.Errorf("expected a valid list to fit, got %v", err)
+		}
+		_, err = NewWordSearchForWords([]string{"CAT", "!!"}, 1)
+		if !errors.Is(err, ErrEmptyWord) {
+			t.Errorf("expected ErrEmptyWord, got %v", err)
+		}
+	})
+
+	t.Run("the same seed finds the same grid", func(t *testing.T) {
+		words := []string{"ONE", "TWO", "SIX", "TEN", "OWL"}
+		opts := []Option{WithDirections([]string{"E", "S"}), WithoutOverlaps(), WithSeed(3)}
+		a, err := NewWordSearchForWords(words, 1, opts...)
+		if err != nil {
+			t.Fatalf("NewWordSearchForWords() error = %v", err)
+		}
+		b, err := NewWordSearchForWords(words, 1, opts...)
+		if err != nil {
+			t.Fatalf("NewWordSearchForWords() error = %v", err)
+		}
+		for i := range a.Grid {
+			if string(a.Grid[i]) != string(b.Grid[i]) {
+				t.Errorf("row %d: expected identical rows, got %s and %s", i, string(a.Grid[i]), string(b.Grid[i]))
+			}
+		}
+	})
+}
+
+// TestCreatePuzzleContext checks that a cancelled or timed out context stops generation with partial results
+func TestCreatePuzzleContext(t *testing.T) {
+	words := []string{"ONE", "TWO", "THREE", "FOUR"}
+
+	t.Run("not cancelled", func(t *testing.T) {
+		ws := newWordSearch(t, 8)
+		unplaced, err := ws.CreatePuzzleContext(context.Background(), words)
+		if err != nil || len(unplaced) > 0 {
+			t.Errorf("expected no error and no unplaced, got %v and %v", err, unplaced)
+		}
+	})
+
+	t.Run("already cancelled", func(t *testing.T) {
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+		ws := newWordSearch(t, 8)
+		unplaced, err := ws.CreatePuzzleContext(ctx, words)
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("expected context.Canceled, got %v", err)
+		}
+		if len(unplaced) != len(words) || len(ws.Placements()) != 0 {
+			t.Errorf("expected every word to be unplaced, got %v and %d placements", unplaced, len(ws.Placements()))
+		}
+		if !errors.Is(ws.Err(), context.Canceled) {
+			t.Errorf("expected Err() to be context.Canceled, got %v", ws.Err())
+		}
+	})
+
+	t.Run("times out during a search that can't succeed", func(t *testing.T) {
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		defer cancel()
+		// 11 four-letter words can't fit into 40 cells without overlapping, so an unlimited search never ends
+		many := []string{"ABLE", "BAKE", "CAKE", "DARE", "EARN", "FAKE", "GAME", "HARE", "IDEA", "JADE", "KALE"}
+		ws := newRectangularWordSearch(t, 10, 4, WithoutOverlaps(), WithBacktracking(0, 0))
+		start := time.Now()
+		unplaced, err := ws.CreatePuzzleContext(ctx, many)
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("expected context.DeadlineExceeded, got %v", err)
+		}
+		if elapsed := time.Since(start); elapsed > time.Second {
+			t.Errorf("expected generation to stop soon after the timeout, took %v", elapsed)
+		}
+		if len(ws.Placements())+len(unplaced) != len(many) {
+			t.Errorf("expected every word to be placed or unplaced, got %d placed and %v", len(ws.Placements()), unplaced)
+		}
+	})
+}
+
+// benchmarkSizes are the grid sizes used by the benchmarks
+var benchmarkSizes = []int{100, 250, 500}
+
+// BenchmarkPlaceWord measures a placement that fails at its last letter, which is the most work
+// PlaceWord can do without changing the grid
+func BenchmarkPlaceWord(b *testing.B) {
+	for _, size := range benchmarkSizes {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			ws, err := NewWordSearch(size, WithSeed(1))
+			if err != nil {
+				b.Fatal(err)
+			}
+			if err := ws.PlaceWord("BLOCKER", 0, 9, "S"); err != nil {
+				b.Fatal(err)
+			}
+			b.ReportAllocs()
+			b.ResetTimer()
+			for range b.N {
+				if err := ws.PlaceWord("GOPHERS", 0, 3, "E"); err == nil {
+					b.Fatal("expected the placement to fail")
+				}
+			}
+		})
+	}
+}
+
+// BenchmarkCreatePuzzle measures filling a large grid with a long list of words
+func BenchmarkCreatePuzzle(b *testing.B) {
+	words := strings.Fields(`GOPHER GOROUTINE CHANNEL INTERFACE POINTER STRUCT SLICE RUNE DEFER PANIC
+		RECOVER SELECT SWITCH FALLTHROUGH PACKAGE IMPORT CONST VARIABLE FUNCTION METHOD RECEIVER
+		CLOSURE GENERIC CONSTRAINT COMPILER LINKER RUNTIME GARBAGE COLLECTOR SCHEDULER MUTEX
+		WAITGROUP CONTEXT DEADLINE TIMEOUT BUFFER READER WRITER ENCODER DECODER MARSHAL`)
+	for _, size := range benchmarkSizes[:2] {
+		b.Run(fmt.Sprintf("%dx%d", size, size), func(b *testing.B) {
+			b.ReportAllocs()
+			for i := range b.N {
+				ws, err := NewWordSearch(size, WithSeed(int64(i)))
+				if err != nil {
+					b.Fatal(err)
+				}
+				ws.CreatePuzzle(words)
+			}
+		})
+	}
+}
+
+// TestUnicode places words from several alphabets and checks the filler and case handling
+func TestUnicode(t *testing.T) {
+	tests := []struct {
+		name     string
+		alphabet string
+		words    []string
+	}{
+		{"Spanish", "ABCDEFGHIJKLMNÑOPQRSTUVWXYZ", []string{"niño", "ESPAÑA", "Mañana"}},
+		{"German", "ABCDEFGHIJKLMNOPQRSTUVWXYZÄÖÜß", []string{"Straße", "Mädchen", "GRÖßE"}},
+		{"Greek", "αβγδεζηθικλμνξοπρστυφχψω", []string{"ΑΘΗΝΑ", "θάλασσα", "ΗΛΙΟΣ"}},
+		{"Russian", "АБВГДЕЁЖЗИЙКЛМНОПРСТУФХЦЧШЩЪЫЬЭЮЯ", []string{"МОСКВА", "ёлка", "Кошка"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ws := newWordSearch(t, 10, WithAlphabet(tt.alphabet), WithSeed(4))
+			alphabet := strings.ToLower(tt.alphabet)
+			for i, row := range ws.Grid {
+				for j, r := range row {
+					if !strings.ContainsRune(alphabet, r) {
+						t.Errorf("[%d][%d]: expected filler from the alphabet, got %c", i, j, r)
+					}
+				}
+			}
+
+			unplaced := ws.CreatePuzzle(tt.words)
+			if len(unplaced) > 0 {
+				t.Fatalf("expected no unplaced, got %v", unplaced)
+			}
+			for _, word := range tt.words {
+				if found := ws.Solve([]string{word}); len(found) == 0 {
+					t.Errorf("expected to find %s in the lowercase grid", word)
+				}
+			}
+			printGrid(t, ws.ReturnGrid(GridWithDots))
+		})
+	}
+
+	t.Run("ß is capitalized without changing the length of the word", func(t *testing.T) {
+		ws := newWordSearch(t, 6)
+		if err := ws.PlaceWord("straße", 0, 0, "E"); err != nil {
+			t.Fatalf("PlaceWord() error = %v", err)
+		}
+		if got := string(ws.Grid[0]); got != "STRAẞE" {
+			t.Errorf("expected STRAẞE, got %s", got)
+		}
+		if p := ws.Placements()[0]; p.EndCol != 5 {
+			t.Errorf("expected the word to end at column 5, got %d", p.EndCol)
+		}
+	})
+
+	t.Run("empty alphabet", func(t *testing.T) {
+		if _, err := NewWordSearch(5, WithAlphabet("123")); !errors.Is(err, ErrEmptyAlphabet) {
+			t.Errorf("expected ErrEmptyAlphabet, got %v", err)
+		}
+	})
+}
+
+// chiSquare returns Pearson's chi-square statistic for observed counts against expected counts
+func chiSquare[K comparable](observed map[K]int, expected map[K]float64) float64 {
+	stat := 0.0
+	for k, e := range expected {
+		d := float64(observed[k]) - e
+		stat += d * d / e
+	}
+	return stat
+}
+
+// TestStartDistribution checks that every start cell and direction where a word fits is equally likely,
+// including the last row and column. The seeds are fixed, so the test is deterministic, and the limits are
+// the chi-square critical values for p = 0.001, so a fair generator passes with plenty of room.
+func TestStartDistribution(t *testing.T) {
+	const size, perCandidate = 5, 40
+	cands := newWordSearch(t, size).candidates(3, vector.Cardinals)
+	trials := len(cands) * perCandidate
+
+	starts := make(map[candidate]int)
+	directions := make(map[string]int)
+	for seed := range trials {
+		ws := newWordSearch(t, size, WithSeed(int64(seed)))
+		if unplaced := ws.CreatePuzzle([]string{"CAT"}); len(unplaced) > 0 {
+			t.Fatalf("seed %d: expected CAT to be placed", seed)
+		}
+		p := ws.Placements()[0]
+		starts[candidate{row: p.Row, col: p.Col, cardinal: p.Cardinal}]++
+		directions[p.Cardinal]++
+	}
+
+	expectedStarts := make(map[candidate]float64)
+	expectedDirections := make(map[string]float64)
+	for _, cand := range cands {
+		expectedStarts[cand] = perCandidate
+		expectedDirections[cand.cardinal] += perCandidate
+	}
+	if len(starts) != len(cands) {
+		t.Errorf("expected all %d start cells and directions to be used, got %d", len(cands), len(starts))
+	}
+	// 95 degrees of freedom
+	if stat := chiSquare(starts, expectedStarts); stat > 141.2 {
+		t.Errorf("start cells and directions aren't evenly spread: chi-square = %.1f", stat)
+	}
+	// 7 degrees of freedom
+	if stat := chiSquare(directions, expectedDirections); stat > 24.3 {
+		t.Errorf("directions aren't spread in proportion to their start cells: chi-square = %.1f, counts %v", stat, directions)
+	}
+
+	edges := 0
+	for cand, n := range starts {
+		if cand.row == size-1 || cand.col == size-1 {
+			edges += n
+		}
+	}
+	if edges == 0 {
+		t.Errorf("expected some words to start on the last row or column")
+	}
+}
+
+// TestStartDistributionRows checks the start rows on a tall, narrow grid, where a word only fits one way
+func TestStartDistributionRows(t *testing.T) {
+	const height, perRow = 8, 100
+	rows := make(map[int]int)
+	for seed := range (height - 2) * perRow {
+		ws := newRectangularWordSearch(t, 1, height, WithSeed(int64(seed)))
+		if unplaced := ws.CreatePuzzle([]string{"CAT"}); len(unplaced) > 0 {
+			t.Fatalf("seed %d: expected CAT to be placed in a 1-wide grid", seed)
+		}
+		rows[ws.Placements()[0].Row]++
+	}
+	// CAT runs N or S, so it can start on any row except the two nearest the edge it runs towards.
+	// The middle rows are starts in both directions, and the rows near the edges in only one.
+	expected := make(map[int]float64)
+	for row := range height {
+		if row >= 2 && row < height-2 {
+			expected[row] = perRow
+		} else {
+			expected[row] = perRow / 2
+		}
+	}
+	// 7 degrees of freedom
+	if stat := chiSquare(rows, expected); stat > 24.3 {
+		t.Errorf("start rows aren't evenly spread: chi-square = %.1f, counts %v", stat, rows)
+	}
+}